@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -103,14 +104,27 @@ func (a *Action) Error() error {
 
 // ActionClient is a client for the actions API.
 type ActionClient struct {
-	action *ResourceActionClient[noopResource]
+	action  *ResourceActionClient[noopResource]
+	batcher *batcher[*Action]
 }
 
 // GetByID retrieves an action by its ID. If the action does not exist, nil is returned.
+//
+// When the client is configured with [WithRequestCoalescing], concurrent calls are
+// batched in a single request.
 func (c *ActionClient) GetByID(ctx context.Context, id int64) (*Action, *Response, error) {
+	if c.batcher != nil {
+		return c.batcher.get(ctx, id)
+	}
 	return c.action.GetByID(ctx, id)
 }
 
+// listByIDs returns the actions for the given IDs, the IDs must fit in a single page.
+func (c *ActionClient) listByIDs(ctx context.Context, ids []int64) ([]*Action, *Response, error) {
+	// Do not send unused per page param
+	return c.action.List(ctx, ActionListOpts{ListOpts: ListOpts{PerPage: -1}, ID: ids})
+}
+
 // ActionListOpts specifies options for listing actions.
 type ActionListOpts struct {
 	ListOpts
@@ -159,6 +173,19 @@ func (c *ActionClient) AllWithOpts(ctx context.Context, opts ActionListOpts) ([]
 	return c.action.All(ctx, opts)
 }
 
+// Iter returns an iterator over all actions for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+//
+// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
+func (c *ActionClient) Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error] {
+	if opts.ListOpts.PerPage == 0 {
+		// Do not send unused per page param
+		opts.ListOpts.PerPage = -1
+	}
+	return c.action.Iter(ctx, opts)
+}
+
 // ResourceActionClient is a client for the actions API exposed by the resource.
 type ResourceActionClient[R actionSupporter] struct {
 	resource string
@@ -219,6 +246,19 @@ func (c *ResourceActionClient[R]) All(ctx context.Context, opts ActionListOpts)
 	})
 }
 
+// Iter returns an iterator over all actions for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ResourceActionClient[R]) Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Action, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 type actionSupporter interface {
 	pathID() (string, error)
 }
@@ -259,3 +299,16 @@ func (c *ResourceActionClient[R]) AllFor(ctx context.Context, resource R, opts A
 		return c.ListFor(ctx, resource, opts)
 	})
 }
+
+// IterFor returns an iterator over all actions for the given Resource.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ResourceActionClient[R]) IterFor(ctx context.Context, resource R, opts ActionListOpts) iter.Seq2[*Action, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Action, *Response, error) {
+		opts.Page = page
+		return c.ListFor(ctx, resource, opts)
+	})
+}
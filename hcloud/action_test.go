@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -79,6 +80,46 @@ func TestActionClientGetByID(t *testing.T) {
 	}
 }
 
+func TestActionClientGetByIDCoalesced(t *testing.T) {
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET",
+			Want: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "/actions", r.URL.Path)
+				assert.ElementsMatch(t, []string{"1", "2", "3"}, r.URL.Query()["id"])
+			},
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 1 }, { "id": 2 }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+	})
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRequestCoalescing(RequestCoalescingOpts{Window: 50 * time.Millisecond}),
+	)
+
+	ctx := context.Background()
+	results := make([]*Action, 3)
+
+	wg := sync.WaitGroup{}
+	for i, id := range []int64{1, 2, 3} {
+		wg.Go(func() {
+			action, _, err := client.Action.GetByID(ctx, id)
+			assert.NoError(t, err)
+			results[i] = action
+		})
+	}
+	wg.Wait()
+
+	require.NotNil(t, results[0])
+	assert.Equal(t, int64(1), results[0].ID)
+	require.NotNil(t, results[1])
+	assert.Equal(t, int64(2), results[1].ID)
+	assert.Nil(t, results[2])
+}
+
 func TestActionClientGetByIDNotFound(t *testing.T) {
 	env := newTestEnv()
 	defer env.Teardown()
@@ -516,6 +557,62 @@ func TestResourceActionClientAllFor(t *testing.T) {
 	})
 }
 
+func TestResourceActionClientIterFor(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/primary_ips/13/actions?page=1&per_page=50",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [
+					{ "id": 1509772237 }
+				],
+				"meta": { "pagination": { "page": 1, "next_page": 2 }}
+			}`,
+		},
+		{
+			Method: "GET", Path: "/primary_ips/13/actions?page=2&per_page=50",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [
+					{ "id": 1509772238 }
+				],
+				"meta": { "pagination": { "page": 2 }}
+			}`,
+		},
+	})
+
+	ids := []int64{}
+	for action, err := range client.PrimaryIP.Action.IterFor(ctx, &PrimaryIP{ID: 13}, ActionListOpts{}) {
+		require.NoError(t, err)
+		ids = append(ids, action.ID)
+	}
+	require.Equal(t, []int64{1509772237, 1509772238}, ids)
+}
+
+func TestActionClientIter(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/actions?id=1&id=2&page=1",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 1 }, { "id": 2 }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+	})
+
+	ids := []int64{}
+	for action, err := range client.Action.Iter(ctx, ActionListOpts{ID: []int64{1, 2}}) {
+		require.NoError(t, err)
+		ids = append(ids, action.ID)
+	}
+	require.Equal(t, []int64{1, 2}, ids)
+}
+
 func ExampleResourceActionClient_ListFor() {
 	ctx := context.Background()
 	client := NewClient()
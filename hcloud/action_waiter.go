@@ -6,6 +6,9 @@ import (
 	"maps"
 	"slices"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ActionWaiter interface {
@@ -24,6 +27,22 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 	// Filter out nil actions
 	actions = slices.DeleteFunc(actions, func(a *Action) bool { return a == nil })
 
+	ids := make([]int64, 0, len(actions))
+	for _, action := range actions {
+		ids = append(ids, action.ID)
+	}
+
+	ctx, span := c.action.client.getTracer().Start(ctx, "wait for actions",
+		trace.WithAttributes(attribute.Int64Slice("hcloud.action.ids", ids)),
+	)
+	defer span.End()
+
+	err := c.waitForFunc(ctx, span, handleUpdate, actions)
+	setSpanStatus(span, err)
+	return err
+}
+
+func (c *ActionClient) waitForFunc(ctx context.Context, span trace.Span, handleUpdate func(update *Action) error, actions []*Action) error {
 	running := make(map[int64]struct{}, len(actions))
 	for _, action := range actions {
 		if action.Status == ActionStatusRunning {
@@ -48,6 +67,8 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 			retries++
 		}
 
+		span.AddEvent("poll", trace.WithAttributes(attribute.Int("hcloud.action.running", len(running))))
+
 		updates := make([]*Action, 0, len(running))
 		for runningIDsChunk := range slices.Chunk(slices.Sorted(maps.Keys(running)), 25) {
 			opts := ActionListOpts{
@@ -82,6 +103,11 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 		for _, update := range updates {
 			if update.Status != ActionStatusRunning {
 				delete(running, update.ID)
+				span.AddEvent("action completed", trace.WithAttributes(
+					attribute.Int64("hcloud.action.id", update.ID),
+					attribute.String("hcloud.action.command", update.Command),
+					attribute.String("hcloud.action.status", string(update.Status)),
+				))
 			}
 
 			if handleUpdate != nil {
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -179,6 +180,19 @@ func (c *CertificateClient) AllWithOpts(ctx context.Context, opts CertificateLis
 	})
 }
 
+// Iter returns an iterator over all Certificates for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *CertificateClient) Iter(ctx context.Context, opts CertificateListOpts) iter.Seq2[*Certificate, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Certificate, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // CertificateCreateOpts specifies options for creating a new Certificate.
 type CertificateCreateOpts struct {
 	Name        string
@@ -242,6 +256,7 @@ func (c *CertificateClient) CreateCertificate(
 ) (CertificateCreateResult, *Response, error) {
 	const opPath = "/certificates"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	reqPath := opPath
 
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math"
 	"math/rand"
 	"net/http"
@@ -15,6 +16,8 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http/httpguts"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
@@ -92,13 +95,29 @@ type Client struct {
 	hetznerEndpoint         string
 	token                   string
 	tokenValid              bool
+	tokenSource             TokenSource
 	retryBackoffFunc        BackoffFunc
 	retryMaxRetries         int
+	retryPolicy             RetryPolicy
 	pollBackoffFunc         BackoffFunc
 	httpClient              *http.Client
 	userAgent               string
 	debugWriter             io.Writer
 	instrumentationRegistry prometheus.Registerer
+	rateLimiter             *RateLimiter
+	coalescing              bool
+	coalescingWindow        time.Duration
+	cacheOpts               *CacheOpts
+	cacheHits               *prometheus.CounterVec
+	cacheMisses             *prometheus.CounterVec
+	circuitBreakerOpts      *CircuitBreakerOpts
+	circuitBreakerState     prometheus.Gauge
+	circuitBreakerRejected  prometheus.Counter
+	logger                  *slog.Logger
+	logOpts                 LogOpts
+	tracerProvider          trace.TracerProvider
+	meterProvider           metric.MeterProvider
+	tracer                  trace.Tracer
 	handler                 handler
 
 	Action           ActionClient
@@ -156,6 +175,18 @@ func WithToken(token string) ClientOption {
 	}
 }
 
+// WithTokenSource configures a Client to fetch the token used for authentication
+// from the [TokenSource], for every request. This takes precedence over [WithToken].
+//
+// When the API rejects the token with [ErrorCodeUnauthorized], the token is fetched
+// again (see [TokenInvalidator]), and the request is sent once again if the token
+// changed.
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(client *Client) {
+		client.tokenSource = source
+	}
+}
+
 // WithPollInterval configures a Client to use the specified interval when
 // polling from the API.
 //
@@ -221,18 +252,30 @@ func WithBackoffFunc(f BackoffFunc) ClientOption {
 type RetryOpts struct {
 	BackoffFunc BackoffFunc
 	MaxRetries  int
+	// Policy reports whether a failed request is retried. Defaults to
+	// [DefaultRetryPolicy], which custom policies may wrap.
+	Policy RetryPolicy
 }
 
 // WithRetryOpts configures a Client to use the specified options when retrying API
 // requests.
 //
 // If [RetryOpts.BackoffFunc] is nil, the existing backoff function will be preserved.
+//
+// Create requests that failed with a transient error, and that the policy declines to
+// retry, are reconciled by looking up the resource by its name: the request is sent
+// again if the resource does not exist, otherwise [ErrResourceCreated] is returned. This
+// applies to the creation of Certificates, Firewalls, named Floating IPs, Load
+// Balancers, Networks, Placement Groups, Primary IPs, Servers, SSH Keys, Storage Boxes,
+// Volumes and Zones. Other create requests (e.g. actions) are not sent again after an
+// ambiguous error.
 func WithRetryOpts(opts RetryOpts) ClientOption {
 	return func(client *Client) {
 		if opts.BackoffFunc != nil {
 			client.retryBackoffFunc = opts.BackoffFunc
 		}
 		client.retryMaxRetries = opts.MaxRetries
+		client.retryPolicy = opts.Policy
 	}
 }
 
@@ -247,12 +290,50 @@ func WithApplication(name, version string) ClientOption {
 
 // WithDebugWriter configures a Client to print debug information to the given
 // writer. To, for example, print debug information on stderr, set it to os.Stderr.
+//
+// For structured logging, see [WithLogger].
 func WithDebugWriter(debugWriter io.Writer) ClientOption {
 	return func(client *Client) {
 		client.debugWriter = debugWriter
 	}
 }
 
+// DefaultLogRedactFields are the JSON fields redacted from the logged bodies by
+// default, see [LogOpts.RedactFields].
+var DefaultLogRedactFields = []string{"root_password", "password", "private_key"}
+
+// LogOpts defines the options used by [WithLogOpts].
+type LogOpts struct {
+	// Bodies enables the logging of the request and response bodies.
+	Bodies bool
+	// RedactFields are the JSON fields to redact from the logged bodies. Defaults to
+	// [DefaultLogRedactFields].
+	RedactFields []string
+}
+
+// WithLogger configures a Client to log a structured record for each request sent to
+// the API, using the given logger.
+//
+// The records include the operation path, the duration, the response status, the
+// remaining rate limit, the correlation ID and the retry attempt of the request.
+// Successful requests are logged with the debug level, failed requests are logged with
+// the warn level and include the error.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+// WithLogOpts configures the records logged by a Client configured with [WithLogger].
+func WithLogOpts(opts LogOpts) ClientOption {
+	return func(client *Client) {
+		if opts.RedactFields == nil {
+			opts.RedactFields = DefaultLogRedactFields
+		}
+		client.logOpts = opts
+	}
+}
+
 // WithHTTPClient configures a Client to perform HTTP requests with httpClient.
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(client *Client) {
@@ -267,6 +348,63 @@ func WithInstrumentation(registry prometheus.Registerer) ClientOption {
 	}
 }
 
+// WithRateLimiter configures a Client to delay the API requests using the given
+// [RateLimiter], before the rate limit of the token is exhausted.
+//
+// The same [RateLimiter] should be shared between all the clients using the same token.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(client *Client) {
+		client.rateLimiter = limiter
+	}
+}
+
+// WithTracerProvider configures a Client to create a span for each API operation, using
+// the given [trace.TracerProvider].
+//
+// The spans are named after the operation path (e.g. "GET /servers/-"), and carry the
+// request method, the response status code, the API error code, the correlation ID and
+// the retry count. A child span is created for each attempt of the operation. Waiting
+// for actions using [ActionClient.WaitForFunc] is traced as well.
+func WithTracerProvider(provider trace.TracerProvider) ClientOption {
+	return func(client *Client) {
+		client.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider configures a Client to record the count and duration of the API
+// operations, using the given [metric.MeterProvider].
+func WithMeterProvider(provider metric.MeterProvider) ClientOption {
+	return func(client *Client) {
+		client.meterProvider = provider
+	}
+}
+
+// RequestCoalescingOpts defines the options used by [WithRequestCoalescing].
+type RequestCoalescingOpts struct {
+	// Window is how long concurrent lookups are collected before being sent in a single
+	// request. Defaults to 10ms.
+	Window time.Duration
+}
+
+// WithRequestCoalescing configures a Client to coalesce concurrent API requests:
+//
+//   - Identical GET requests that are in flight at the same time are only sent once,
+//     each caller receives a copy of the response.
+//   - Concurrent [ActionClient.GetByID] calls are collected during a short window, and
+//     sent as a single list request filtered by ID.
+//
+// Other lookups by ID (e.g. [ServerClient.GetByID]) are not batched, as the API does not
+// support filtering their list by ID, but identical lookups are de-duplicated.
+func WithRequestCoalescing(opts RequestCoalescingOpts) ClientOption {
+	return func(client *Client) {
+		client.coalescing = true
+		client.coalescingWindow = opts.Window
+		if client.coalescingWindow <= 0 {
+			client.coalescingWindow = 10 * time.Millisecond
+		}
+	}
+}
+
 // NewClient creates a new client.
 func NewClient(options ...ClientOption) *Client {
 	client := &Client{
@@ -285,6 +423,8 @@ func NewClient(options ...ClientOption) *Client {
 		retryMaxRetries: 5,
 
 		pollBackoffFunc: ConstantBackoff(500 * time.Millisecond),
+
+		logOpts: LogOpts{RedactFields: DefaultLogRedactFields},
 	}
 
 	for _, option := range options {
@@ -294,12 +434,25 @@ func NewClient(options ...ClientOption) *Client {
 	if client.instrumentationRegistry != nil {
 		i := instrumentation.New("api", client.instrumentationRegistry)
 		client.httpClient.Transport = i.InstrumentedRoundTripper(client.httpClient.Transport)
+		if client.cacheOpts != nil {
+			client.cacheHits, client.cacheMisses = i.CacheCounters()
+		}
+		if client.circuitBreakerOpts != nil {
+			client.circuitBreakerState, client.circuitBreakerRejected = i.CircuitBreakerMetrics(client.circuitBreakerOpts.Name)
+		}
+	}
+
+	if client.tracerProvider != nil {
+		client.tracer = client.tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(Version))
 	}
 
 	client.handler = assembleHandlerChain(client)
 
 	// Cloud API
 	client.Action = ActionClient{action: &ResourceActionClient[noopResource]{client: client}}
+	if client.coalescing {
+		client.Action.batcher = newBatcher(client.coalescingWindow, 25, client.Action.listByIDs, func(a *Action) int64 { return a.ID })
+	}
 	client.Datacenter = DatacenterClient{client: client}
 	client.FloatingIP = FloatingIPClient{client: client, Action: &ResourceActionClient[*FloatingIP]{client: client, resource: "floating_ips"}}
 	client.Image = ImageClient{client: client, Action: &ResourceActionClient[*Image]{client: client, resource: "images"}}
@@ -346,10 +499,19 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body io.Re
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Accept", "application/json")
 
-	if !c.tokenValid {
+	token, tokenValid := c.token, c.tokenValid
+	if c.tokenSource != nil {
+		token, err = c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorization token: %w", err)
+		}
+		tokenValid = httpguts.ValidHeaderFieldValue(token)
+	}
+
+	if !tokenValid {
 		return nil, errors.New("authorization token contains invalid characters")
-	} else if c.token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	} else if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
 
 	if body != nil {
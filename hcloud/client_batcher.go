@@ -0,0 +1,131 @@
+package hcloud
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// batcher collects the IDs requested concurrently during a short window, and fetches
+// them using a single list request.
+type batcher[T any] struct {
+	// window is how long IDs are collected before the batch is fetched.
+	window time.Duration
+	// size is the maximum number of IDs in a batch, the batch is fetched as soon as it
+	// is full.
+	size int
+
+	fetch func(ctx context.Context, ids []int64) ([]T, *Response, error)
+	idOf  func(T) int64
+
+	mu      sync.Mutex
+	pending *batch[T]
+}
+
+type batch[T any] struct {
+	// ctx is the context of the first caller, without its cancellation. It is canceled
+	// once all the callers stopped waiting for the batch, so the batch never outlives
+	// the deadlines of its callers.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// waiting is the number of callers waiting for the batch.
+	waiting int
+	ids     []int64
+
+	done    chan struct{}
+	results map[int64]T
+	resp    *Response
+	err     error
+}
+
+func newBatcher[T any](
+	window time.Duration,
+	size int,
+	fetch func(ctx context.Context, ids []int64) ([]T, *Response, error),
+	idOf func(T) int64,
+) *batcher[T] {
+	return &batcher[T]{window: window, size: size, fetch: fetch, idOf: idOf}
+}
+
+// get adds the ID to the pending batch, and waits for the batch to be fetched. The
+// zero value is returned if the ID was not found.
+func (b *batcher[T]) get(ctx context.Context, id int64) (T, *Response, error) {
+	var zero T
+
+	b.mu.Lock()
+	current := b.pending
+	if current == nil {
+		batchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		current = &batch[T]{ctx: batchCtx, cancel: cancel, done: make(chan struct{})}
+		b.pending = current
+		time.AfterFunc(b.window, func() { b.flush(current) })
+	}
+	current.waiting++
+	if !slices.Contains(current.ids, id) {
+		current.ids = append(current.ids, id)
+	}
+	if len(current.ids) >= b.size {
+		b.pending = nil
+		go b.run(current)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		b.leave(current)
+		return zero, nil, ctx.Err()
+	case <-current.done:
+	}
+
+	if current.err != nil {
+		return zero, current.resp.clone(), current.err
+	}
+	return current.results[id], current.resp.clone(), nil
+}
+
+// leave removes a caller that stopped waiting from the batch. The batch is canceled
+// when no caller is waiting anymore.
+func (b *batcher[T]) leave(current *batch[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current.waiting--
+	if current.waiting > 0 {
+		return
+	}
+	if b.pending == current {
+		b.pending = nil
+	}
+	current.cancel()
+}
+
+// flush fetches the batch if it is still pending.
+func (b *batcher[T]) flush(current *batch[T]) {
+	b.mu.Lock()
+	if b.pending != current {
+		// Already fetched because it was full
+		b.mu.Unlock()
+		return
+	}
+	b.pending = nil
+	b.mu.Unlock()
+
+	b.run(current)
+}
+
+func (b *batcher[T]) run(current *batch[T]) {
+	defer close(current.done)
+	defer current.cancel()
+
+	var items []T
+	items, current.resp, current.err = b.fetch(current.ctx, current.ids)
+	if current.err != nil {
+		return
+	}
+
+	current.results = make(map[int64]T, len(items))
+	for _, item := range items {
+		current.results[b.idOf(item)] = item
+	}
+}
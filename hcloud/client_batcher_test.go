@@ -0,0 +1,137 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Run("concurrent", func(t *testing.T) {
+		var mu sync.Mutex
+		calls := [][]int64{}
+
+		b := newBatcher(50*time.Millisecond, 25,
+			func(_ context.Context, ids []int64) ([]*Action, *Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls = append(calls, slices.Sorted(slices.Values(ids)))
+
+				result := []*Action{}
+				for _, id := range ids {
+					if id != 404 {
+						result = append(result, &Action{ID: id})
+					}
+				}
+				return result, &Response{}, nil
+			},
+			func(a *Action) int64 { return a.ID },
+		)
+
+		ids := []int64{1, 2, 3, 2, 404}
+		results := make([]*Action, len(ids))
+
+		wg := sync.WaitGroup{}
+		for i, id := range ids {
+			wg.Go(func() {
+				result, resp, err := b.get(context.Background(), id)
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				results[i] = result
+			})
+		}
+		wg.Wait()
+
+		require.Equal(t, [][]int64{{1, 2, 3, 404}}, calls)
+		for i, id := range ids {
+			if id == 404 {
+				assert.Nil(t, results[i])
+			} else {
+				assert.Equal(t, id, results[i].ID)
+			}
+		}
+	})
+
+	t.Run("full batch", func(t *testing.T) {
+		calls := make(chan []int64, 2)
+
+		b := newBatcher(time.Hour, 2,
+			func(_ context.Context, ids []int64) ([]*Action, *Response, error) {
+				calls <- ids
+				return []*Action{}, &Response{}, nil
+			},
+			func(a *Action) int64 { return a.ID },
+		)
+
+		wg := sync.WaitGroup{}
+		for _, id := range []int64{1, 2} {
+			wg.Go(func() {
+				_, _, err := b.get(context.Background(), id)
+				assert.NoError(t, err)
+			})
+		}
+		wg.Wait()
+
+		assert.Len(t, <-calls, 2)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		b := newBatcher(time.Millisecond, 25,
+			func(_ context.Context, _ []int64) ([]*Action, *Response, error) {
+				return nil, nil, fmt.Errorf("failure")
+			},
+			func(a *Action) int64 { return a.ID },
+		)
+
+		result, _, err := b.get(context.Background(), 1)
+		require.EqualError(t, err, "failure")
+		assert.Nil(t, result)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		b := newBatcher(time.Hour, 25,
+			func(_ context.Context, _ []int64) ([]*Action, *Response, error) {
+				return []*Action{}, &Response{}, nil
+			},
+			func(a *Action) int64 { return a.ID },
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err := b.get(ctx, 1)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		fetchCtx := make(chan context.Context, 1)
+		b := newBatcher(time.Millisecond, 25,
+			func(ctx context.Context, _ []int64) ([]*Action, *Response, error) {
+				fetchCtx <- ctx
+				// Hanging request
+				<-ctx.Done()
+				return nil, nil, ctx.Err()
+			},
+			func(a *Action) int64 { return a.ID },
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, _, err := b.get(ctx, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// The batch is canceled once its only caller stopped waiting
+		select {
+		case <-(<-fetchCtx).Done():
+		case <-time.After(time.Second):
+			t.Fatal("batch was not canceled")
+		}
+	})
+}
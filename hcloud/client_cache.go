@@ -0,0 +1,121 @@
+package hcloud
+
+import (
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTLs defines how long the responses of the catalog resources are cached
+// by default, see [CacheOpts.TTLs].
+var DefaultCacheTTLs = map[string]time.Duration{
+	"/isos":                time.Hour,
+	"/load_balancer_types": time.Hour,
+	"/locations":           time.Hour,
+	"/pricing":             time.Hour,
+	"/server_types":        time.Hour,
+	"/storage_box_types":   time.Hour,
+	"/images?type=system":  15 * time.Minute,
+}
+
+// CacheEntry is a response stored in a [ResponseCache].
+type CacheEntry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Expires is the time until the entry may be used without revalidation.
+	Expires time.Time
+}
+
+// ResponseCache stores API responses.
+//
+// The keys start with the path of the resource (e.g. "/server_types"), followed by a
+// space and the request URL. This allows invalidating all the entries of a resource
+// using its path as prefix.
+//
+// A ResponseCache must be safe for concurrent use.
+type ResponseCache interface {
+	// Get returns the entry for the given key, if any.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the entry for the given key.
+	Set(key string, entry *CacheEntry)
+	// Invalidate deletes all the entries with a key starting with the given prefix. An
+	// empty prefix deletes all the entries.
+	Invalidate(prefix string)
+}
+
+// MemoryCache is an in-memory [ResponseCache].
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache creates a new [MemoryCache].
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]*CacheEntry{}}
+}
+
+// Get returns the entry for the given key, if any.
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+// Set stores the entry for the given key.
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry
+}
+
+// Invalidate deletes all the entries with a key starting with the given prefix. An
+// empty prefix deletes all the entries.
+func (c *MemoryCache) Invalidate(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.DeleteFunc(c.entries, func(key string, _ *CacheEntry) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// CacheOpts defines the options used by [WithCache].
+type CacheOpts struct {
+	// Cache stores the responses. Defaults to a [MemoryCache].
+	Cache ResponseCache
+	// TTLs defines how long the responses are cached, per resource path. The path may
+	// include query parameters that the requests must match exactly (e.g.
+	// "/images?type=system" does not match "/images?type=system&type=app"), other
+	// parameters are ignored. A resource path also matches its sub paths, the longest
+	// match is used. Defaults to [DefaultCacheTTLs].
+	TTLs map[string]time.Duration
+}
+
+// WithCache configures a Client to cache the responses of GET requests, for the
+// resources listed in [CacheOpts.TTLs].
+//
+// Once an entry expired, it is revalidated using a conditional request if the
+// response included an ETag or Last-Modified header. Successful requests that modify
+// a resource invalidate the cached entries of this resource, and of the resources they
+// also modify (e.g. creating an image from a server invalidates the images).
+//
+// When configured with [WithInstrumentation], the cache hits and misses are counted.
+//
+// A [ResponseCache] should only be shared between clients using the same token.
+func WithCache(opts CacheOpts) ClientOption {
+	return func(client *Client) {
+		if opts.Cache == nil {
+			opts.Cache = NewMemoryCache()
+		}
+		if opts.TTLs == nil {
+			opts.TTLs = DefaultCacheTTLs
+		}
+		client.cacheOpts = &opts
+	}
+}
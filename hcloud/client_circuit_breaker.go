@@ -0,0 +1,235 @@
+package hcloud
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CircuitBreakerState is the state of the circuit breaker configured with
+// [WithCircuitBreaker].
+type CircuitBreakerState int
+
+const (
+	// CircuitBreakerStateClosed lets all the requests through.
+	CircuitBreakerStateClosed CircuitBreakerState = iota
+	// CircuitBreakerStateHalfOpen lets a limited number of probe requests through, to
+	// find out whether the API recovered.
+	CircuitBreakerStateHalfOpen
+	// CircuitBreakerStateOpen fails all the requests without sending them.
+	CircuitBreakerStateOpen
+)
+
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerStateClosed:
+		return "closed"
+	case CircuitBreakerStateHalfOpen:
+		return "half-open"
+	case CircuitBreakerStateOpen:
+		return "open"
+	}
+	return fmt.Sprintf("unknown (%d)", int(s))
+}
+
+// CircuitBreakerOpenError is returned for requests that are not sent because the
+// circuit breaker is open.
+type CircuitBreakerOpenError struct {
+	// Until is the time at which probe requests will be let through.
+	Until time.Time
+}
+
+func (e CircuitBreakerOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker is open until %s", e.Until.Format(time.RFC3339))
+}
+
+// CircuitBreakerOpts defines the options used by [WithCircuitBreaker].
+type CircuitBreakerOpts struct {
+	// FailureRate is the rate of failed requests, between 0 and 1, at which the circuit
+	// breaker opens. Defaults to 0.5.
+	FailureRate float64
+	// MinRequests is the minimum number of requests within the window before the
+	// failure rate is evaluated. Defaults to 10.
+	MinRequests int
+	// Window is the duration over which the requests are counted. Defaults to 1 minute.
+	Window time.Duration
+	// OpenTimeout is how long the circuit breaker stays open before letting probe
+	// requests through. Defaults to 30 seconds.
+	OpenTimeout time.Duration
+	// HalfOpenProbes is the number of concurrent probe requests let through while the
+	// circuit breaker is half-open. Defaults to 1.
+	HalfOpenProbes int
+	// Name identifies the circuit breaker in the metrics (client label), when multiple
+	// clients share the same registry. Defaults to a unique name per client.
+	Name string
+}
+
+// WithCircuitBreaker configures a Client to stop sending requests during sustained API
+// outages.
+//
+// The circuit breaker opens once the rate of failed requests reaches
+// [CircuitBreakerOpts.FailureRate]. Requests fail with [ErrorCodeServiceError],
+// [ErrorCodeBadGateway], [ErrorCodeTimeout], a 5xx status code or a network error.
+// While open, requests fail immediately with a [CircuitBreakerOpenError], which is not
+// retried. After [CircuitBreakerOpts.OpenTimeout], probe requests are let through: the
+// circuit breaker closes when a probe succeeds, and opens again when a probe fails.
+//
+// When configured with [WithInstrumentation], the state of the circuit breaker and the
+// number of rejected requests are exposed, labelled with [CircuitBreakerOpts.Name].
+func WithCircuitBreaker(opts CircuitBreakerOpts) ClientOption {
+	return func(client *Client) {
+		if opts.FailureRate <= 0 {
+			opts.FailureRate = 0.5
+		}
+		if opts.MinRequests <= 0 {
+			opts.MinRequests = 10
+		}
+		if opts.Window <= 0 {
+			opts.Window = time.Minute
+		}
+		if opts.OpenTimeout <= 0 {
+			opts.OpenTimeout = 30 * time.Second
+		}
+		if opts.HalfOpenProbes <= 0 {
+			opts.HalfOpenProbes = 1
+		}
+		if opts.Name == "" {
+			opts.Name = fmt.Sprintf("client-%d", circuitBreakerSeq.Add(1))
+		}
+		client.circuitBreakerOpts = &opts
+	}
+}
+
+// circuitBreakerSeq is used to name the circuit breakers of the clients.
+var circuitBreakerSeq atomic.Int64
+
+type circuitBreaker struct {
+	opts CircuitBreakerOpts
+
+	mu    sync.Mutex
+	state CircuitBreakerState
+	// windowStart is the start of the window in which requests and failures are counted.
+	windowStart time.Time
+	requests    int
+	failures    int
+	// openedAt is the last time the circuit breaker opened.
+	openedAt time.Time
+	// probes is the number of probe requests in flight.
+	probes int
+
+	stateGauge prometheus.Gauge
+	rejected   prometheus.Counter
+
+	now func() time.Time
+}
+
+func newCircuitBreaker(opts CircuitBreakerOpts, stateGauge prometheus.Gauge, rejected prometheus.Counter) *circuitBreaker {
+	return &circuitBreaker{
+		opts:       opts,
+		stateGauge: stateGauge,
+		rejected:   rejected,
+		now:        time.Now,
+	}
+}
+
+// allow reports whether a request may be sent, and whether it is a probe request.
+func (b *circuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	if b.state == CircuitBreakerStateOpen && !now.Before(b.openedAt.Add(b.opts.OpenTimeout)) {
+		b.setState(CircuitBreakerStateHalfOpen)
+	}
+
+	switch b.state {
+	case CircuitBreakerStateHalfOpen:
+		if b.probes < b.opts.HalfOpenProbes {
+			b.probes++
+			return true, nil
+		}
+	case CircuitBreakerStateOpen:
+	default:
+		return false, nil
+	}
+
+	if b.rejected != nil {
+		b.rejected.Inc()
+	}
+	return false, CircuitBreakerOpenError{Until: b.openedAt.Add(b.opts.OpenTimeout)}
+}
+
+// record records the outcome of a request that was allowed.
+func (b *circuitBreaker) record(probe, failure bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	if probe {
+		b.probes--
+		if b.state != CircuitBreakerStateHalfOpen {
+			return
+		}
+		if failure {
+			b.open(now)
+		} else {
+			b.close(now)
+		}
+		return
+	}
+
+	if b.state != CircuitBreakerStateClosed {
+		return
+	}
+
+	if now.Sub(b.windowStart) >= b.opts.Window {
+		b.windowStart = now
+		b.requests = 0
+		b.failures = 0
+	}
+
+	b.requests++
+	if failure {
+		b.failures++
+	}
+
+	if b.requests >= b.opts.MinRequests && float64(b.failures)/float64(b.requests) >= b.opts.FailureRate {
+		b.open(now)
+	}
+}
+
+// release gives back the probe slot of a request whose outcome is unknown.
+func (b *circuitBreaker) release(probe bool) {
+	if !probe {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probes--
+}
+
+func (b *circuitBreaker) open(now time.Time) {
+	b.openedAt = now
+	b.setState(CircuitBreakerStateOpen)
+}
+
+func (b *circuitBreaker) close(now time.Time) {
+	b.windowStart = now
+	b.requests = 0
+	b.failures = 0
+	b.setState(CircuitBreakerStateClosed)
+}
+
+func (b *circuitBreaker) setState(state CircuitBreakerState) {
+	b.state = state
+	if b.stateGauge != nil {
+		b.stateGauge.Set(float64(state))
+	}
+}
@@ -0,0 +1,72 @@
+package hcloud
+
+// ClientFacade exposes the subsystems of a [Client] through their interfaces, so single
+// subsystems can be swapped or decorated, for example to test or audit the calls of a
+// program:
+//
+//	type auditServerClient struct {
+//		hcloud.IServerClient
+//	}
+//
+//	func (c *auditServerClient) Delete(ctx context.Context, server *hcloud.Server) (*hcloud.Response, error) {
+//		slog.InfoContext(ctx, "deleting server", "id", server.ID)
+//		return c.IServerClient.Delete(ctx, server)
+//	}
+//
+//	facade := hcloud.NewClientFacade(client)
+//	facade.Server = &auditServerClient{facade.Server}
+type ClientFacade struct {
+	Action           IActionClient
+	Certificate      ICertificateClient
+	Firewall         IFirewallClient
+	FloatingIP       IFloatingIPClient
+	Image            IImageClient
+	ISO              IISOClient
+	LoadBalancer     ILoadBalancerClient
+	LoadBalancerType ILoadBalancerTypeClient
+	Location         ILocationClient
+	Network          INetworkClient
+	Pricing          IPricingClient
+	Server           IServerClient
+	ServerType       IServerTypeClient
+	StorageBox       IStorageBoxClient
+	SSHKey           ISSHKeyClient
+	Volume           IVolumeClient
+	PlacementGroup   IPlacementGroupClient
+	RDNS             IRDNSClient
+	PrimaryIP        IPrimaryIPClient
+	StorageBoxType   IStorageBoxTypeClient
+	Zone             IZoneClient
+
+	// Deprecated: [DatacenterClient] is deprecated and will be removed after the 2026-10-01. See
+	// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+	Datacenter IDatacenterClient
+}
+
+// NewClientFacade returns a [ClientFacade] using the subsystems of the [Client].
+func NewClientFacade(client *Client) *ClientFacade {
+	return &ClientFacade{
+		Action:           &client.Action,
+		Certificate:      &client.Certificate,
+		Firewall:         &client.Firewall,
+		FloatingIP:       &client.FloatingIP,
+		Image:            &client.Image,
+		ISO:              &client.ISO,
+		LoadBalancer:     &client.LoadBalancer,
+		LoadBalancerType: &client.LoadBalancerType,
+		Location:         &client.Location,
+		Network:          &client.Network,
+		Pricing:          &client.Pricing,
+		Server:           &client.Server,
+		ServerType:       &client.ServerType,
+		StorageBox:       &client.StorageBox,
+		SSHKey:           &client.SSHKey,
+		Volume:           &client.Volume,
+		PlacementGroup:   &client.PlacementGroup,
+		RDNS:             &client.RDNS,
+		PrimaryIP:        &client.PrimaryIP,
+		StorageBoxType:   &client.StorageBoxType,
+		Zone:             &client.Zone,
+		Datacenter:       &client.Datacenter,
+	}
+}
@@ -0,0 +1,52 @@
+package hcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+type deleteRecordingServerClient struct {
+	IServerClient
+	deleted []int64
+}
+
+func (c *deleteRecordingServerClient) Delete(ctx context.Context, server *Server) (*Response, error) {
+	c.deleted = append(c.deleted, server.ID)
+	return c.IServerClient.Delete(ctx, server)
+}
+
+func TestClientFacade(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1 }}`,
+		},
+		{
+			Method: "DELETE", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "action": { "id": 10, "status": "success" }}`,
+		},
+	})
+
+	facade := NewClientFacade(client)
+	assert.Same(t, &client.Server, facade.Server)
+	assert.Same(t, &client.Zone, facade.Zone)
+
+	recorder := &deleteRecordingServerClient{IServerClient: facade.Server}
+	facade.Server = recorder
+
+	result, _, err := facade.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+
+	_, err = facade.Server.Delete(ctx, result)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, recorder.deleted)
+}
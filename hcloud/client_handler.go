@@ -29,15 +29,57 @@ func assembleHandlerChain(client *Client) handler {
 	// Read rate limit headers
 	h = wrapRateLimitHandler(h)
 
+	// Delay request if the rate limit is about to be exceeded
+	if client.rateLimiter != nil {
+		h = wrapRateLimiterHandler(h, client.rateLimiter)
+	}
+
 	// Build error from response
 	h = wrapErrorHandler(h)
 
+	// Fail fast during sustained API outages
+	if client.circuitBreakerOpts != nil {
+		h = wrapCircuitBreakerHandler(h, newCircuitBreaker(*client.circuitBreakerOpts, client.circuitBreakerState, client.circuitBreakerRejected))
+	}
+
+	// Log each attempt of the operation
+	if client.logger != nil {
+		h = wrapLoggingHandler(h, client.logger, client.logOpts)
+	}
+
+	tracing := client.tracerProvider != nil || client.meterProvider != nil
+
+	// Trace each attempt of the operation
+	if tracing {
+		h = wrapTracingAttemptHandler(h, client.getTracer())
+	}
+
+	// Send the request again with a refreshed token if the token is rejected
+	if client.tokenSource != nil {
+		h = wrapTokenSourceHandler(h, client.tokenSource)
+	}
+
 	// Retry request if condition are met
-	h = wrapRetryHandler(h, client.retryBackoffFunc, client.retryMaxRetries)
+	h = wrapRetryHandler(h, client.retryBackoffFunc, client.retryMaxRetries, client.retryPolicy)
+
+	// De-duplicate identical requests in flight
+	if client.coalescing {
+		h = wrapCoalesceHandler(h)
+	}
+
+	// Serve responses from the cache if enabled
+	if client.cacheOpts != nil {
+		h = wrapCacheHandler(h, *client.cacheOpts, client.cacheHits, client.cacheMisses)
+	}
 
 	// Finally parse the response body into the provided schema
 	h = wrapParseHandler(h)
 
+	// Trace and measure the whole operation
+	if tracing {
+		h = wrapTracingHandler(h, client.getTracer(), client.getMeter())
+	}
+
 	return h
 }
 
@@ -0,0 +1,193 @@
+package hcloud
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+)
+
+// cacheRelatedResources lists the resources modified by the operations on another
+// resource, in addition to the resource itself. The keys are operation paths, as
+// stored by [ctxutil.SetOpPath].
+var cacheRelatedResources = map[string][]string{
+	"/servers/-/actions/create_image": {"/images"},
+	"/servers/-/actions/rebuild":      {"/images"},
+}
+
+func wrapCacheHandler(wrapped handler, opts CacheOpts, hits, misses *prometheus.CounterVec) handler {
+	return &cacheHandler{
+		handler: wrapped,
+		cache:   opts.Cache,
+		ttls:    opts.TTLs,
+		hits:    hits,
+		misses:  misses,
+		now:     time.Now,
+	}
+}
+
+type cacheHandler struct {
+	handler handler
+	cache   ResponseCache
+	ttls    map[string]time.Duration
+
+	// hits and misses are nil when the instrumentation is disabled
+	hits   *prometheus.CounterVec
+	misses *prometheus.CounterVec
+
+	now func() time.Time
+}
+
+func (h *cacheHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	opPath := ctxutil.OpPath(req.Context())
+	resource := resourcePath(opPath)
+	if resource == "" {
+		return h.handler.Do(req, v)
+	}
+
+	if req.Method != http.MethodGet {
+		resp, err = h.handler.Do(req, v)
+		if err == nil {
+			h.cache.Invalidate(resource + " ")
+			for _, related := range cacheRelatedResources[opPath] {
+				h.cache.Invalidate(related + " ")
+			}
+		}
+		return resp, err
+	}
+
+	ttl := h.ttl(opPath, req.URL.Query())
+	if ttl <= 0 {
+		return h.handler.Do(req, v)
+	}
+
+	key := resource + " " + req.URL.String()
+
+	entry, ok := h.cache.Get(key)
+	if ok && h.now().Before(entry.Expires) {
+		h.count(h.hits, opPath)
+		return entry.response(req), nil
+	}
+
+	if ok {
+		// Revalidate the expired entry, if the API supports it
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		} else if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err = h.handler.Do(req, v)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case ok && resp.StatusCode == http.StatusNotModified:
+		h.count(h.hits, opPath)
+		entry = &CacheEntry{
+			StatusCode: entry.StatusCode,
+			Header:     entry.Header,
+			Body:       entry.Body,
+			Expires:    h.now().Add(ttl),
+		}
+		h.cache.Set(key, entry)
+		return entry.response(req), nil
+
+	case resp.StatusCode == http.StatusOK:
+		h.count(h.misses, opPath)
+		h.cache.Set(key, &CacheEntry{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       resp.body,
+			Expires:    h.now().Add(ttl),
+		})
+	}
+
+	return resp, err
+}
+
+// ttl returns the TTL of the longest resource path that matches the operation path
+// and the request query.
+func (h *cacheHandler) ttl(opPath string, query url.Values) time.Duration {
+	var (
+		result  time.Duration
+		longest int
+	)
+
+	for key, ttl := range h.ttls {
+		path, rawQuery, _ := strings.Cut(key, "?")
+		if opPath != path && !strings.HasPrefix(opPath, path+"/") {
+			continue
+		}
+		if !matchQuery(rawQuery, query) {
+			continue
+		}
+		if len(key) > longest {
+			result, longest = ttl, len(key)
+		}
+	}
+
+	return result
+}
+
+func (h *cacheHandler) count(counter *prometheus.CounterVec, opPath string) {
+	if counter != nil {
+		counter.WithLabelValues(opPath).Inc()
+	}
+}
+
+// matchQuery returns whether the query has exactly the values of each parameter of the
+// raw query, e.g. "type=system" does not match "type=system&type=app". Other parameters
+// of the query (e.g. the pagination) are ignored.
+func matchQuery(rawQuery string, query url.Values) bool {
+	want, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return false
+	}
+	for key, values := range want {
+		if !slices.Equal(slices.Sorted(slices.Values(values)), slices.Sorted(slices.Values(query[key]))) {
+			return false
+		}
+	}
+	return true
+}
+
+// resourcePath returns the first segment of the operation path, e.g. "/images" for
+// "/images/-/actions".
+func resourcePath(opPath string) string {
+	if !strings.HasPrefix(opPath, "/") {
+		return ""
+	}
+	resource, _, _ := strings.Cut(opPath[1:], "/")
+	if resource == "" {
+		return ""
+	}
+	return "/" + resource
+}
+
+// response returns a new [Response] for the cache entry.
+func (e *CacheEntry) response(req *http.Request) *Response {
+	return &Response{
+		Response: &http.Response{
+			Status:        http.StatusText(e.StatusCode),
+			StatusCode:    e.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        e.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(e.Body)),
+			ContentLength: int64(len(e.Body)),
+			Request:       req,
+		},
+		body: e.Body,
+	}
+}
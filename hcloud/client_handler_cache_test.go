@@ -0,0 +1,196 @@
+package hcloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+)
+
+func newCacheTestRequest(t *testing.T, method, opPath, url string) *http.Request {
+	t.Helper()
+
+	ctx := ctxutil.SetOpPath(context.Background(), opPath)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	require.NoError(t, err)
+	return req
+}
+
+func TestCacheHandler(t *testing.T) {
+	t.Run("hit and expire", func(t *testing.T) {
+		requests := 0
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests++
+			return fakeResponse(t, 200, `{"server_types": []}`, true), nil
+		}}
+
+		registry := prometheus.NewRegistry()
+		hits := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "hits"}, []string{"api_endpoint"})
+		misses := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "misses"}, []string{"api_endpoint"})
+		registry.MustRegister(hits, misses)
+
+		h := wrapCacheHandler(m, CacheOpts{Cache: NewMemoryCache(), TTLs: DefaultCacheTTLs}, hits, misses).(*cacheHandler)
+		now := time.Now()
+		h.now = func() time.Time { return now }
+
+		for range 3 {
+			resp, err := h.Do(newCacheTestRequest(t, "GET", "/server_types?%s", "/v1/server_types?page=1"), nil)
+			require.NoError(t, err)
+			assert.Equal(t, 200, resp.StatusCode)
+			assert.JSONEq(t, `{"server_types": []}`, string(resp.body))
+		}
+		assert.Equal(t, 1, requests)
+		assert.InDelta(t, 2, testutil.ToFloat64(hits.WithLabelValues("/server_types")), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(misses.WithLabelValues("/server_types")), 0)
+
+		now = now.Add(2 * time.Hour)
+		_, err := h.Do(newCacheTestRequest(t, "GET", "/server_types?%s", "/v1/server_types?page=1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("revalidate", func(t *testing.T) {
+		requests := []*http.Request{}
+		m := &mockHandler{func(req *http.Request, _ any) (*Response, error) {
+			requests = append(requests, req)
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				return fakeResponse(t, 304, "", false), nil
+			}
+			resp := fakeResponse(t, 200, `{"locations": []}`, true)
+			resp.Header.Set("ETag", `"v1"`)
+			return resp, nil
+		}}
+
+		h := wrapCacheHandler(m, CacheOpts{Cache: NewMemoryCache(), TTLs: DefaultCacheTTLs}, nil, nil).(*cacheHandler)
+		now := time.Now()
+		h.now = func() time.Time { return now }
+
+		_, err := h.Do(newCacheTestRequest(t, "GET", "/locations/%d", "/v1/locations/1"), nil)
+		require.NoError(t, err)
+
+		now = now.Add(2 * time.Hour)
+		resp, err := h.Do(newCacheTestRequest(t, "GET", "/locations/%d", "/v1/locations/1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"locations": []}`, string(resp.body))
+
+		require.Len(t, requests, 2)
+		assert.Equal(t, `"v1"`, requests[1].Header.Get("If-None-Match"))
+
+		// The entry was refreshed
+		_, err = h.Do(newCacheTestRequest(t, "GET", "/locations/%d", "/v1/locations/1"), nil)
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+	})
+
+	t.Run("not cached", func(t *testing.T) {
+		requests := 0
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests++
+			return fakeResponse(t, 200, `{}`, true), nil
+		}}
+
+		h := wrapCacheHandler(m, CacheOpts{Cache: NewMemoryCache(), TTLs: DefaultCacheTTLs}, nil, nil)
+
+		for _, req := range []*http.Request{
+			newCacheTestRequest(t, "GET", "/servers/%d", "/v1/servers/1"),
+			newCacheTestRequest(t, "GET", "/servers/%d", "/v1/servers/1"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=snapshot"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=snapshot"),
+			newCacheTestRequest(t, "GET", "", "/v1/server_types"),
+			newCacheTestRequest(t, "GET", "", "/v1/server_types"),
+		} {
+			_, err := h.Do(req, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 6, requests)
+	})
+
+	t.Run("invalidate on change", func(t *testing.T) {
+		requests := 0
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests++
+			return fakeResponse(t, 200, `{}`, true), nil
+		}}
+
+		cache := NewMemoryCache()
+		h := wrapCacheHandler(m, CacheOpts{Cache: cache, TTLs: DefaultCacheTTLs}, nil, nil)
+
+		for _, req := range []*http.Request{
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+			newCacheTestRequest(t, "POST", "/images/%d/actions/change_protection", "/v1/images/1/actions/change_protection"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+		} {
+			_, err := h.Do(req, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, requests)
+
+		cache.Invalidate("")
+		_, err := h.Do(newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 4, requests)
+	})
+
+	t.Run("invalidate related resources", func(t *testing.T) {
+		requests := 0
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests++
+			return fakeResponse(t, 200, `{}`, true), nil
+		}}
+
+		h := wrapCacheHandler(m, CacheOpts{Cache: NewMemoryCache(), TTLs: DefaultCacheTTLs}, nil, nil)
+
+		for _, req := range []*http.Request{
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+			newCacheTestRequest(t, "POST", "/servers/%d/actions/create_image", "/v1/servers/1/actions/create_image"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+			newCacheTestRequest(t, "POST", "/servers/%d/actions/rebuild", "/v1/servers/1/actions/rebuild"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+			newCacheTestRequest(t, "POST", "/servers/%d/actions/poweron", "/v1/servers/1/actions/poweron"),
+			newCacheTestRequest(t, "GET", "/images?%s", "/v1/images?type=system"),
+		} {
+			_, err := h.Do(req, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 6, requests)
+	})
+}
+
+func TestCacheHandlerTTL(t *testing.T) {
+	h := &cacheHandler{ttls: map[string]time.Duration{
+		"/images":             time.Minute,
+		"/images?type=system": time.Hour,
+		"/server_types":       2 * time.Hour,
+	}}
+
+	for _, tc := range []struct {
+		opPath string
+		query  string
+		want   time.Duration
+	}{
+		{"/images", "type=system&page=1", time.Hour},
+		{"/images", "type=snapshot", time.Minute},
+		{"/images", "type=system&type=app", time.Minute},
+		{"/images", "type=snapshot&type=system&page=1", time.Minute},
+		{"/images/-", "", time.Minute},
+		{"/server_types/-", "", 2 * time.Hour},
+		{"/server_types_other", "", 0},
+		{"/servers", "", 0},
+	} {
+		t.Run(tc.opPath+"?"+tc.query, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, h.ttl(tc.opPath, query))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package hcloud
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+func wrapCircuitBreakerHandler(wrapped handler, breaker *circuitBreaker) handler {
+	return &circuitBreakerHandler{wrapped, breaker}
+}
+
+type circuitBreakerHandler struct {
+	handler handler
+	breaker *circuitBreaker
+}
+
+func (h *circuitBreakerHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	probe, err := h.breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = h.handler.Do(req, v)
+
+	if err != nil && req.Context().Err() != nil {
+		// The request was canceled, which tells nothing about the API.
+		h.breaker.release(probe)
+		return resp, err
+	}
+
+	h.breaker.record(probe, circuitBreakerFailure(resp, err))
+	return resp, err
+}
+
+// circuitBreakerFailure reports whether the error shows that the API is unavailable.
+func circuitBreakerFailure(resp *Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+
+	switch {
+	case IsError(err, ErrorCodeServiceError, ErrorCodeBadGateway, ErrorCodeTimeout):
+		return true
+	case errors.Is(err, ErrStatusCode):
+		return resp != nil && resp.Response != nil && resp.StatusCode >= http.StatusInternalServerError
+	case errors.As(err, &netErr):
+		return true
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package hcloud
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestCircuitBreakerHandler(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	registry := prometheus.NewRegistry()
+	stateGauge, rejected := instrumentation.New("api", registry).CircuitBreakerMetrics("test")
+
+	breaker := newCircuitBreaker(CircuitBreakerOpts{
+		FailureRate:    0.5,
+		MinRequests:    4,
+		Window:         time.Minute,
+		OpenTimeout:    30 * time.Second,
+		HalfOpenProbes: 1,
+	}, stateGauge, rejected)
+	breaker.now = func() time.Time { return now }
+
+	var result error
+	calls := 0
+	h := wrapCircuitBreakerHandler(&mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		calls++
+		return nil, result
+	}}, breaker)
+
+	serviceError := ErrorFromSchema(schema.Error{Code: string(ErrorCodeServiceError), Message: "service error"})
+	notFound := ErrorFromSchema(schema.Error{Code: string(ErrorCodeNotFound), Message: "not found"})
+
+	do := func() error {
+		req, err := http.NewRequest("GET", "/", nil)
+		require.NoError(t, err)
+		_, err = h.Do(req, nil)
+		return err
+	}
+
+	// Client errors do not count as failures
+	result = notFound
+	for range 4 {
+		require.ErrorIs(t, do(), notFound)
+	}
+	assert.Equal(t, CircuitBreakerStateClosed, breaker.state)
+
+	// The failure rate is computed over the window
+	now = now.Add(time.Minute)
+	result = nil
+	require.NoError(t, do())
+	require.NoError(t, do())
+	result = serviceError
+	require.Error(t, do())
+	assert.Equal(t, CircuitBreakerStateClosed, breaker.state)
+	require.Error(t, do())
+	assert.Equal(t, CircuitBreakerStateOpen, breaker.state)
+	assert.InDelta(t, 2, testutil.ToFloat64(stateGauge), 0)
+
+	// Requests fail fast while open
+	calls = 0
+	err := do()
+	require.EqualError(t, err, "circuit breaker is open until 2025-01-01T00:01:30Z")
+	assert.Equal(t, CircuitBreakerOpenError{Until: now.Add(30 * time.Second)}, err)
+	assert.Equal(t, 0, calls)
+	assert.InDelta(t, 1, testutil.ToFloat64(rejected), 0)
+
+	// A failed probe opens the circuit breaker again
+	now = now.Add(30 * time.Second)
+	require.ErrorIs(t, do(), serviceError)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, CircuitBreakerStateOpen, breaker.state)
+	require.ErrorAs(t, do(), &CircuitBreakerOpenError{})
+
+	// A successful probe closes the circuit breaker
+	now = now.Add(30 * time.Second)
+	result = nil
+	require.NoError(t, do())
+	assert.Equal(t, CircuitBreakerStateClosed, breaker.state)
+	assert.InDelta(t, 0, testutil.ToFloat64(stateGauge), 0)
+}
+
+func TestCircuitBreakerHalfOpenProbes(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(CircuitBreakerOpts{OpenTimeout: time.Second, HalfOpenProbes: 1}, nil, nil)
+	breaker.now = func() time.Time { return now }
+	breaker.open(now)
+
+	now = now.Add(time.Second)
+
+	probe, err := breaker.allow()
+	require.NoError(t, err)
+	assert.True(t, probe)
+
+	// Only one probe is let through at a time
+	_, err = breaker.allow()
+	require.ErrorAs(t, err, &CircuitBreakerOpenError{})
+
+	// A canceled probe gives back its slot
+	breaker.release(probe)
+	probe, err = breaker.allow()
+	require.NoError(t, err)
+	assert.True(t, probe)
+}
+
+func TestCircuitBreakerFailure(t *testing.T) {
+	testCases := []struct {
+		name    string
+		resp    *Response
+		err     error
+		failure bool
+	}{
+		{name: "success"},
+		{name: "bad gateway", err: ErrorFromSchema(schema.Error{Code: string(ErrorCodeBadGateway)}), failure: true},
+		{name: "timeout", err: ErrorFromSchema(schema.Error{Code: string(ErrorCodeTimeout)}), failure: true},
+		{name: "conflict", err: ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict)})},
+		{name: "http 503", resp: fakeResponse(t, 503, "", false), err: fmt.Errorf("%w %d", ErrStatusCode, 503), failure: true},
+		{name: "http 429", resp: fakeResponse(t, 429, "", false), err: fmt.Errorf("%w %d", ErrStatusCode, 429)},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, failure: true},
+		{name: "random error", err: errors.New("random error")},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.failure, circuitBreakerFailure(testCase.resp, testCase.err))
+		})
+	}
+}
+
+func TestClientWithCircuitBreaker(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithCircuitBreaker(CircuitBreakerOpts{MinRequests: 2}),
+	)
+
+	// The circuit breaker opens after 2 failures, the remaining retries fail fast
+	server.Expect([]mockutil.Request{
+		{Method: "GET", Path: "/servers/1", Status: 502},
+		{Method: "GET", Path: "/servers/1", Status: 502},
+	})
+
+	_, _, err := client.Server.GetByID(ctx, 1)
+	require.ErrorAs(t, err, &CircuitBreakerOpenError{})
+}
@@ -0,0 +1,86 @@
+package hcloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+func wrapCoalesceHandler(wrapped handler) handler {
+	return &coalesceHandler{handler: wrapped, inflight: map[string]*coalescedCall{}}
+}
+
+// coalesceHandler de-duplicates identical GET requests that are in flight at the same
+// time: only the first request is sent, and the other callers receive a copy of its
+// response.
+type coalesceHandler struct {
+	handler handler
+
+	mu       sync.Mutex
+	inflight map[string]*coalescedCall
+}
+
+type coalescedCall struct {
+	done chan struct{}
+	resp *Response
+	err  error
+}
+
+func (h *coalesceHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	if req.Method != http.MethodGet {
+		return h.handler.Do(req, v)
+	}
+
+	// Requests with different tokens must not share their responses
+	key := req.Header.Get("Authorization") + " " + req.URL.String()
+
+	h.mu.Lock()
+	if call, ok := h.inflight[key]; ok {
+		h.mu.Unlock()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-call.done:
+		}
+
+		if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+			// The first caller gave up, send our own request
+			return h.handler.Do(req, v)
+		}
+		return call.resp.clone(), call.err
+	}
+
+	call := &coalescedCall{done: make(chan struct{})}
+	h.inflight[key] = call
+	h.mu.Unlock()
+
+	call.resp, call.err = h.handler.Do(req, v)
+
+	h.mu.Lock()
+	delete(h.inflight, key)
+	h.mu.Unlock()
+	close(call.done)
+
+	// The handlers up the chain modify the response (e.g. the pagination meta), while
+	// the waiters are cloning it.
+	return call.resp.clone(), call.err
+}
+
+// clone returns a copy of the response, with its own readable body.
+func (r *Response) clone() *Response {
+	if r == nil {
+		return nil
+	}
+
+	cloned := &Response{Meta: r.Meta, body: r.body}
+	if r.Response != nil {
+		httpResp := *r.Response
+		httpResp.Body = io.NopCloser(bytes.NewReader(r.body))
+		cloned.Response = &httpResp
+	}
+	return cloned
+}
@@ -0,0 +1,154 @@
+package hcloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesceHandler(t *testing.T) {
+	t.Run("identical requests", func(t *testing.T) {
+		var requests atomic.Int32
+		release := make(chan struct{})
+
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests.Add(1)
+			<-release
+			return fakeResponse(t, 200, `{"data": "Hello"}`, true), nil
+		}}
+		h := wrapCoalesceHandler(m)
+
+		wg := sync.WaitGroup{}
+		for range 3 {
+			wg.Go(func() {
+				req, err := http.NewRequest("GET", "/servers/1", nil)
+				assert.NoError(t, err)
+
+				resp, err := h.Do(req, nil)
+				assert.NoError(t, err)
+
+				body, err := io.ReadAll(resp.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"data": "Hello"}`, string(body))
+			})
+		}
+
+		// Wait for all requests to be in flight
+		assert.Eventually(t, func() bool {
+			ch := h.(*coalesceHandler)
+			ch.mu.Lock()
+			defer ch.mu.Unlock()
+			return len(ch.inflight) == 1
+		}, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("other requests", func(t *testing.T) {
+		var requests atomic.Int32
+
+		m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			requests.Add(1)
+			return fakeResponse(t, 200, "", false), nil
+		}}
+		h := wrapCoalesceHandler(m)
+
+		for _, r := range []struct{ method, path, token string }{
+			{"GET", "/servers/1", "a"},
+			{"GET", "/servers/1", "b"},
+			{"GET", "/servers/2", "a"},
+			{"POST", "/servers", "a"},
+		} {
+			req, err := http.NewRequest(r.method, r.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+r.token)
+
+			_, err = h.Do(req, nil)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(4), requests.Load())
+	})
+
+	t.Run("first caller canceled", func(t *testing.T) {
+		var requests atomic.Int32
+
+		m := &mockHandler{func(req *http.Request, _ any) (*Response, error) {
+			if requests.Add(1) == 1 {
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			}
+			return fakeResponse(t, 200, "", false), nil
+		}}
+		h := wrapCoalesceHandler(m)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		first, err := http.NewRequestWithContext(ctx, "GET", "/servers/1", nil)
+		require.NoError(t, err)
+		second, err := http.NewRequest("GET", "/servers/1", nil)
+		require.NoError(t, err)
+
+		done := make(chan error)
+		go func() {
+			_, err := h.Do(first, nil)
+			done <- err
+		}()
+		assert.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, time.Millisecond)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		_, err = h.Do(second, nil)
+		require.NoError(t, err)
+		require.ErrorIs(t, <-done, context.Canceled)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
+
+func TestClientRequestCoalescing(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		// Keep the request in flight, so the concurrent calls are coalesced
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"servers": [{ "id": 1 }],
+			"meta": { "pagination": { "page": 1, "per_page": 25, "last_page": 1, "total_entries": 1 }}
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRequestCoalescing(RequestCoalescingOpts{}),
+	)
+
+	wg := sync.WaitGroup{}
+	for range 10 {
+		wg.Go(func() {
+			servers, resp, err := client.Server.List(context.Background(), ServerListOpts{})
+			assert.NoError(t, err)
+			assert.Len(t, servers, 1)
+			if assert.NotNil(t, resp.Meta.Pagination) {
+				assert.Equal(t, 1, resp.Meta.Pagination.LastPage)
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.Less(t, requests.Load(), int32(10))
+}
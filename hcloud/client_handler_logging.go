@@ -0,0 +1,130 @@
+package hcloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+)
+
+// redactedValue replaces the secrets in the logged bodies.
+const redactedValue = "REDACTED"
+
+func wrapLoggingHandler(wrapped handler, logger *slog.Logger, opts LogOpts) handler {
+	return &loggingHandler{handler: wrapped, logger: logger, opts: opts}
+}
+
+// loggingHandler logs a structured record for each request sent to the API.
+type loggingHandler struct {
+	handler handler
+	logger  *slog.Logger
+	opts    LogOpts
+}
+
+func (h *loggingHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	ctx := req.Context()
+
+	opPath := ctxutil.OpPath(ctx)
+	if opPath == "" {
+		opPath = req.URL.Path
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("op", opPath),
+		slog.String("path", req.URL.RequestURI()),
+		slog.Int("attempt", retryAttempt(ctx)),
+	}
+
+	if h.opts.Bodies && req.GetBody != nil && req.ContentLength > 0 {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, slog.String("request_body", redactBody(data, h.opts.RedactFields)))
+	}
+
+	start := time.Now()
+	resp, err = h.handler.Do(req, v)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	if resp != nil && resp.Response != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.Int("ratelimit_remaining", resp.Meta.Ratelimit.Remaining),
+		)
+		if correlationID := resp.internalCorrelationID(); correlationID != "" {
+			attrs = append(attrs, slog.String("correlation_id", correlationID))
+		}
+		if h.opts.Bodies && len(resp.body) > 0 {
+			attrs = append(attrs, slog.String("response_body", redactBody(resp.body, h.opts.RedactFields)))
+		}
+	}
+
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelWarn
+
+		var apiErr Error
+		if errors.As(err, &apiErr) {
+			attrs = append(attrs, slog.Any("error", apiErr))
+		} else {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+	}
+
+	h.logger.LogAttrs(ctx, level, "api request", attrs...)
+
+	return resp, err
+}
+
+// redactBody replaces the values of the given fields in a JSON body. Bodies that are
+// not valid JSON are not logged, as they cannot be redacted.
+func redactBody(data []byte, fields []string) string {
+	var body any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// Keep the numbers as is, IDs must not be logged as floats (e.g. 4.2e+07)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil || decoder.More() {
+		return redactedValue
+	}
+
+	body = redactValue(body, fields)
+
+	result, err := json.Marshal(body)
+	if err != nil {
+		return redactedValue
+	}
+	return string(result)
+}
+
+func redactValue(value any, fields []string) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, o := range value {
+			if o != nil && slices.Contains(fields, key) {
+				value[key] = redactedValue
+				continue
+			}
+			value[key] = redactValue(o, fields)
+		}
+		return value
+	case []any:
+		for i, o := range value {
+			value[i] = redactValue(o, fields)
+		}
+		return value
+	default:
+		return value
+	}
+}
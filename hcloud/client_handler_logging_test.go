@@ -0,0 +1,151 @@
+package hcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestLoggingHandler(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    LogOpts
+		body    string
+		wrapped func(req *http.Request, v any) (*Response, error)
+		want    map[string]any
+	}{
+		{
+			name: "success",
+			wrapped: func(_ *http.Request, _ any) (*Response, error) {
+				resp := fakeResponse(t, 200, `{"server": {"id": 1}}`, true)
+				resp.Header.Set("X-Correlation-Id", "abc123")
+				resp.Meta.Ratelimit.Remaining = 3599
+				return resp, nil
+			},
+			want: map[string]any{
+				"level":               "DEBUG",
+				"msg":                 "api request",
+				"method":              "POST",
+				"op":                  "/servers/-/actions/reset_password",
+				"path":                "/servers/1/actions/reset_password",
+				"attempt":             float64(0),
+				"status":              float64(200),
+				"ratelimit_remaining": float64(3599),
+				"correlation_id":      "abc123",
+			},
+		},
+		{
+			name: "api error",
+			wrapped: func(_ *http.Request, _ any) (*Response, error) {
+				resp := fakeResponse(t, 404, `{"error": {"code": "not_found", "message": "server not found"}}`, true)
+				return resp, ErrorFromSchema(schema.Error{Code: string(ErrorCodeNotFound), Message: "server not found"})
+			},
+			want: map[string]any{
+				"level":               "WARN",
+				"msg":                 "api request",
+				"method":              "POST",
+				"op":                  "/servers/-/actions/reset_password",
+				"path":                "/servers/1/actions/reset_password",
+				"attempt":             float64(0),
+				"status":              float64(404),
+				"ratelimit_remaining": float64(0),
+				"error":               map[string]any{"code": "not_found", "msg": "server not found"},
+			},
+		},
+		{
+			name: "bodies",
+			opts: LogOpts{Bodies: true, RedactFields: DefaultLogRedactFields},
+			body: `{"ssh_keys": ["key"], "root_password": "secret"}`,
+			wrapped: func(_ *http.Request, _ any) (*Response, error) {
+				resp := fakeResponse(t, 201, `{"root_password": "secret", "action": {"id": 1, "certificate": {"private_key": "key"}}}`, true)
+				return resp, nil
+			},
+			want: map[string]any{
+				"level":               "DEBUG",
+				"msg":                 "api request",
+				"method":              "POST",
+				"op":                  "/servers/-/actions/reset_password",
+				"path":                "/servers/1/actions/reset_password",
+				"attempt":             float64(0),
+				"status":              float64(201),
+				"ratelimit_remaining": float64(0),
+				"request_body":        `{"root_password":"REDACTED","ssh_keys":["key"]}`,
+				"response_body":       `{"action":{"certificate":{"private_key":"REDACTED"},"id":1},"root_password":"REDACTED"}`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			logger := slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+			ctx := ctxutil.SetOpPath(context.Background(), "/servers/%d/actions/reset_password")
+			req, err := http.NewRequestWithContext(ctx, "POST", "/servers/1/actions/reset_password", strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			h := wrapLoggingHandler(&mockHandler{testCase.wrapped}, logger, testCase.opts)
+			_, _ = h.Do(req, nil)
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(output.Bytes(), &record))
+
+			assert.NotEmpty(t, record["time"])
+			assert.NotEmpty(t, record["duration"])
+			delete(record, "time")
+			delete(record, "duration")
+
+			assert.Equal(t, testCase.want, record)
+		})
+	}
+}
+
+func TestLoggingHandlerRetryAttempt(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	attempts := 0
+	h := wrapRetryHandler(
+		wrapLoggingHandler(&mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+			attempts++
+			if attempts == 1 {
+				return fakeResponse(t, 409, "", false), ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict), Message: "conflict"})
+			}
+			return fakeResponse(t, 200, "", false), nil
+		}}, logger, LogOpts{}),
+		ConstantBackoff(0), 5, nil,
+	)
+
+	req, err := http.NewRequest("GET", "/servers", nil)
+	require.NoError(t, err)
+
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"attempt":0`)
+	assert.Contains(t, lines[0], `"level":"WARN"`)
+	assert.Contains(t, lines[1], `"attempt":1`)
+	assert.Contains(t, lines[1], `"op":"/servers"`)
+}
+
+func TestRedactBody(t *testing.T) {
+	assert.Equal(t, `{"password":"REDACTED","subaccounts":[{"password":"REDACTED"}],"username":"u1"}`,
+		redactBody([]byte(`{"password": "a", "username": "u1", "subaccounts": [{"password": "b"}]}`), DefaultLogRedactFields))
+	assert.Equal(t, `{"root_password":null}`, redactBody([]byte(`{"root_password": null}`), DefaultLogRedactFields))
+	assert.Equal(t, "REDACTED", redactBody([]byte(`password=a`), DefaultLogRedactFields))
+	assert.Equal(t, "REDACTED", redactBody([]byte(`{} {}`), DefaultLogRedactFields))
+	assert.Equal(t, `{"id":42000000,"ids":[9007199254740993],"price":"1.50","ratio":0.5}`,
+		redactBody([]byte(`{"id": 42000000, "ids": [9007199254740993], "price": "1.50", "ratio": 0.5}`), DefaultLogRedactFields))
+}
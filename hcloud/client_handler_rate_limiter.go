@@ -0,0 +1,29 @@
+package hcloud
+
+import (
+	"net/http"
+)
+
+func wrapRateLimiterHandler(wrapped handler, limiter *RateLimiter) handler {
+	return &rateLimiterHandler{wrapped, limiter}
+}
+
+type rateLimiterHandler struct {
+	handler handler
+	limiter *RateLimiter
+}
+
+func (h *rateLimiterHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	if err := h.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err = h.handler.Do(req, v)
+
+	// The rate limit headers are parsed by the [rateLimitHandler]
+	if resp != nil {
+		h.limiter.update(resp.Meta.Ratelimit)
+	}
+
+	return resp, err
+}
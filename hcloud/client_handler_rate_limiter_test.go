@@ -0,0 +1,50 @@
+package hcloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterHandler(t *testing.T) {
+	limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+	requests := 0
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		requests++
+		resp := fakeResponse(t, 200, "", false)
+		resp.Meta.Ratelimit = Ratelimit{Limit: 3600, Remaining: 0, Reset: now.Add(time.Hour)}
+		return resp, nil
+	}}
+	h := wrapRateLimiterHandler(m, limiter)
+
+	req, err := http.NewRequest("GET", "/", nil)
+	require.NoError(t, err)
+
+	// The limit is unknown, the first request is not delayed
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// The limit is exhausted, the second request is delayed until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = h.Do(req.WithContext(ctx), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, requests)
+
+	// The limiter is shared with the handler of another client
+	other := wrapRateLimiterHandler(m, limiter)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = other.Do(req.WithContext(ctx), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, requests)
+}
@@ -1,20 +1,50 @@
 package hcloud
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"time"
 )
 
-func wrapRetryHandler(wrapped handler, backoffFunc BackoffFunc, maxRetries int) handler {
-	return &retryHandler{wrapped, backoffFunc, maxRetries}
+// ErrResourceCreated is returned when a create request failed with an ambiguous error
+// (e.g. a timeout), but the resource was found afterwards. The API most likely processed
+// the request, so it is not sent again to prevent creating a duplicate resource.
+var ErrResourceCreated = errors.New("request failed, but the resource was created")
+
+// RetryPolicy reports whether a failed request must be sent again, given the response
+// and error of the last attempt.
+type RetryPolicy func(req *http.Request, resp *Response, err error) bool
+
+// DefaultRetryPolicy is the [RetryPolicy] used when [RetryOpts.Policy] is nil.
+//
+// Requests failing with a transient error are retried, as long as sending them again is
+// safe. Requests with an idempotent method (GET, HEAD, PUT, DELETE) are always retried.
+// Other requests (POST) are only retried when the error shows that the API did not
+// process them ([ErrorCodeConflict], [ErrorCodeRateLimitExceeded]), but not after an
+// ambiguous error ([ErrorCodeTimeout], [ErrorCodeBadGateway], ...), unless they can be
+// reconciled (see [WithRetryOpts]).
+func DefaultRetryPolicy(req *http.Request, resp *Response, err error) bool {
+	if !retryPolicy(resp, err) {
+		return false
+	}
+	return isIdempotentMethod(req.Method) || !isAmbiguousError(err)
+}
+
+func wrapRetryHandler(wrapped handler, backoffFunc BackoffFunc, maxRetries int, policy RetryPolicy) handler {
+	if policy == nil {
+		policy = DefaultRetryPolicy
+	}
+	return &retryHandler{wrapped, backoffFunc, maxRetries, policy}
 }
 
 type retryHandler struct {
 	handler     handler
 	backoffFunc BackoffFunc
 	maxRetries  int
+	policy      RetryPolicy
 }
 
 func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error) {
@@ -23,7 +53,7 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 
 	for {
 		// Clone the request using the original context
-		cloned, err := cloneRequest(req, ctx)
+		cloned, err := cloneRequest(req, context.WithValue(ctx, retryAttemptKey{}, retries))
 		if err != nil {
 			return nil, err
 		}
@@ -39,7 +69,15 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 				return resp, err
 			}
 
-			if retries < h.maxRetries && retryPolicy(resp, err) {
+			if retries >= h.maxRetries {
+				return resp, err
+			}
+
+			retry, reconcileErr := h.retry(ctx, cloned, resp, err)
+			if reconcileErr != nil {
+				return resp, reconcileErr
+			}
+			if retry {
 				select {
 				case <-ctx.Done():
 					return resp, err
@@ -54,6 +92,79 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 	}
 }
 
+// retry reports whether the failed request must be sent again.
+//
+// Create requests declined by the policy after a transient error are reconciled: the
+// request is only sent again if the resource does not exist.
+func (h *retryHandler) retry(ctx context.Context, req *http.Request, resp *Response, err error) (bool, error) {
+	if h.policy(req, resp, err) {
+		return true, nil
+	}
+
+	reconcile, ok := ctx.Value(createReconcilerKey{}).(createReconciler)
+	if !ok || !retryPolicy(resp, err) {
+		return false, nil
+	}
+
+	// Prevent the requests sent by the reconciler from being reconciled themselves.
+	exists, reconcileErr := reconcile(context.WithValue(ctx, createReconcilerKey{}, nil))
+	switch {
+	case reconcileErr != nil:
+		// Whether the request was processed is unknown, it is not safe to send it again.
+		return false, nil
+	case exists:
+		return false, fmt.Errorf("%w: %w", ErrResourceCreated, err)
+	}
+	return true, nil
+}
+
+// retryAttemptKey is the context key of the retry attempt of a request.
+type retryAttemptKey struct{}
+
+// retryAttempt returns the retry attempt of the request, 0 for the first attempt.
+func retryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+// createReconcilerKey is the context key of the [createReconciler] of a create request.
+type createReconcilerKey struct{}
+
+// createReconciler reports whether the resource of a create request exists.
+type createReconciler func(ctx context.Context) (bool, error)
+
+// withCreateReconciler returns a context in which a create request that failed with an
+// ambiguous error is only retried if the reconciler does not find the resource.
+func withCreateReconciler(ctx context.Context, reconcile createReconciler) context.Context {
+	return context.WithValue(ctx, createReconcilerKey{}, reconcile)
+}
+
+// reconcileByName returns a context in which a create request is reconciled by looking
+// up the resource by its name.
+func reconcileByName[T any](ctx context.Context, name string, getByName func(context.Context, string) (*T, *Response, error)) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return withCreateReconciler(ctx, func(ctx context.Context) (bool, error) {
+		resource, _, err := getByName(ctx, name)
+		return resource != nil, err
+	})
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isAmbiguousError reports whether the API might have processed the request that
+// failed with the given transient error.
+func isAmbiguousError(err error) bool {
+	return !IsError(err, ErrorCodeConflict, ErrorCodeRateLimitExceeded)
+}
+
 func retryPolicy(resp *Response, err error) bool {
 	if err != nil {
 		var apiErr Error
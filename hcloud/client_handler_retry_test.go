@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
 )
 
@@ -96,7 +97,7 @@ func TestRetryHandler(t *testing.T) {
 
 				retryCount++
 				return 0
-			}, 5)
+			}, 5, nil)
 
 			client := NewClient(WithToken("dummy"))
 			req, err := client.NewRequest(context.Background(), "GET", "/", nil)
@@ -193,3 +194,149 @@ func TestRetryPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	timeout := ErrorFromSchema(schema.Error{Code: string(ErrorCodeTimeout), Message: "timeout"})
+	conflict := ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict), Message: "conflict"})
+	notFound := ErrorFromSchema(schema.Error{Code: string(ErrorCodeNotFound), Message: "not found"})
+
+	testCases := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{name: "get timeout", method: "GET", err: timeout, want: true},
+		{name: "delete timeout", method: "DELETE", err: timeout, want: true},
+		{name: "post timeout", method: "POST", err: timeout, want: false},
+		{name: "post conflict", method: "POST", err: conflict, want: true},
+		{name: "get not found", method: "GET", err: notFound, want: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, "/", nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.want, DefaultRetryPolicy(req, nil, testCase.err))
+		})
+	}
+}
+
+func TestClientWithRetryPolicy(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	calls := 0
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{
+			BackoffFunc: ConstantBackoff(0),
+			MaxRetries:  5,
+			Policy: func(req *http.Request, resp *Response, err error) bool {
+				calls++
+				return IsError(err, ErrorCodeServiceError) || DefaultRetryPolicy(req, resp, err)
+			},
+		}),
+	)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  503,
+			JSONRaw: `{ "error": { "code": "service_error", "message": "service error" }}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1 }}`,
+		},
+	})
+
+	result, _, err := client.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientCreateReconcile(t *testing.T) {
+	timeout := mockutil.Request{
+		Method: "POST", Path: "/ssh_keys",
+		Status:  504,
+		JSONRaw: `{ "error": { "code": "timeout", "message": "timeout" }}`,
+	}
+	opts := SSHKeyCreateOpts{Name: "my-key", PublicKey: "ssh-ed25519 AAAA"}
+
+	t.Run("resource created", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			timeout,
+			{
+				Method: "GET", Path: "/ssh_keys?name=my-key",
+				Status:  200,
+				JSONRaw: `{ "ssh_keys": [{ "id": 1, "name": "my-key" }]}`,
+			},
+		})
+
+		_, _, err := client.SSHKey.Create(ctx, opts)
+		require.ErrorIs(t, err, ErrResourceCreated)
+		require.True(t, IsError(err, ErrorCodeTimeout))
+	})
+
+	t.Run("resource missing", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			timeout,
+			{
+				Method: "GET", Path: "/ssh_keys?name=my-key",
+				Status:  200,
+				JSONRaw: `{ "ssh_keys": []}`,
+			},
+			{
+				Method: "POST", Path: "/ssh_keys",
+				Status:  201,
+				JSONRaw: `{ "ssh_key": { "id": 1, "name": "my-key" }}`,
+			},
+		})
+
+		result, _, err := client.SSHKey.Create(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+	})
+
+	t.Run("lookup failed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			timeout,
+			{
+				Method: "GET", Path: "/ssh_keys?name=my-key",
+				Status:  403,
+				JSONRaw: `{ "error": { "code": "forbidden", "message": "forbidden" }}`,
+			},
+		})
+
+		_, _, err := client.SSHKey.Create(ctx, opts)
+		require.EqualError(t, err, "timeout (timeout)")
+	})
+
+	t.Run("not reconcilable", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		// Labels are not unique, the Floating IP is neither looked up nor created again.
+		server.Expect([]mockutil.Request{
+			{
+				Method: "POST", Path: "/floating_ips",
+				Status:  504,
+				JSONRaw: `{ "error": { "code": "timeout", "message": "timeout" }}`,
+			},
+		})
+
+		_, _, err := client.FloatingIP.Create(ctx, FloatingIPCreateOpts{
+			Type:         FloatingIPTypeIPv4,
+			HomeLocation: &Location{Name: "fsn1"},
+			Labels:       map[string]string{"env": "prod"},
+		})
+		require.EqualError(t, err, "timeout (timeout)")
+	})
+}
@@ -0,0 +1,54 @@
+package hcloud
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+func wrapTokenSourceHandler(wrapped handler, source TokenSource) handler {
+	return &tokenSourceHandler{wrapped, source}
+}
+
+type tokenSourceHandler struct {
+	handler handler
+	source  TokenSource
+}
+
+// Do sends the request with the token set by [Client.NewRequest], and sends it once
+// again with a refreshed token if the API rejects the token.
+func (h *tokenSourceHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	cloned, err := cloneRequest(req, req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = h.handler.Do(cloned, v)
+	if !IsError(err, ErrorCodeUnauthorized) {
+		return resp, err
+	}
+
+	if invalidator, ok := h.source.(TokenInvalidator); ok {
+		invalidator.InvalidateToken()
+	}
+
+	token, tokenErr := h.source.Token(req.Context())
+	if tokenErr != nil || !httpguts.ValidHeaderFieldValue(token) {
+		return resp, err
+	}
+
+	authorization := fmt.Sprintf("Bearer %s", token)
+	if authorization == req.Header.Get("Authorization") {
+		// The token did not change, sending the request again would fail the same way.
+		return resp, err
+	}
+
+	cloned, cloneErr := cloneRequest(req, req.Context())
+	if cloneErr != nil {
+		return resp, err
+	}
+	cloned.Header.Set("Authorization", authorization)
+
+	return h.handler.Do(cloned, v)
+}
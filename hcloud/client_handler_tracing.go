@@ -0,0 +1,169 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+)
+
+// instrumentationName is the name of the OpenTelemetry tracer and meter.
+const instrumentationName = "github.com/hetznercloud/hcloud-go/v2/hcloud"
+
+// getTracer returns the tracer of the client, or a no-op tracer when tracing is
+// disabled.
+func (c *Client) getTracer() trace.Tracer {
+	if c.tracer == nil {
+		return tracenoop.Tracer{}
+	}
+	return c.tracer
+}
+
+// getMeter returns the meter of the client, or a no-op meter when metrics are
+// disabled.
+func (c *Client) getMeter() metric.Meter {
+	if c.meterProvider == nil {
+		return metricnoop.Meter{}
+	}
+	return c.meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(Version))
+}
+
+// tracingOperationKey is the context key of the [tracingOperation].
+type tracingOperationKey struct{}
+
+// tracingOperation is shared between the tracing handler and the attempt handler of
+// an API operation.
+type tracingOperation struct {
+	name     string
+	attempts int
+}
+
+func wrapTracingHandler(wrapped handler, tracer trace.Tracer, meter metric.Meter) handler {
+	h := &tracingHandler{handler: wrapped, tracer: tracer}
+
+	// Errors are only returned for invalid instrument names or options
+	h.requests, _ = meter.Int64Counter(
+		"hcloud.client.requests",
+		metric.WithDescription("Number of API operations, including the retries."),
+		metric.WithUnit("{request}"),
+	)
+	h.duration, _ = meter.Float64Histogram(
+		"hcloud.client.request.duration",
+		metric.WithDescription("Duration of API operations, including the retries."),
+		metric.WithUnit("s"),
+	)
+
+	return h
+}
+
+// tracingHandler creates a span for each API operation, and records the operation
+// metrics.
+type tracingHandler struct {
+	handler handler
+	tracer  trace.Tracer
+
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func (h *tracingHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	opPath := ctxutil.OpPath(req.Context())
+	if opPath == "" {
+		opPath = req.URL.Path
+	}
+
+	op := &tracingOperation{name: req.Method + " " + opPath}
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("hcloud.operation", opPath),
+	}
+
+	start := time.Now()
+	ctx, span := h.tracer.Start(req.Context(), op.name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	ctx = context.WithValue(ctx, tracingOperationKey{}, op)
+
+	resp, err = h.handler.Do(req.WithContext(ctx), v)
+
+	attrs = append(attrs, responseAttributes(resp, err)...)
+
+	h.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+	h.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+
+	span.SetAttributes(attrs...)
+	span.SetAttributes(attribute.Int("hcloud.retry_count", max(op.attempts-1, 0)))
+	if resp != nil && resp.Response != nil {
+		if correlationID := resp.internalCorrelationID(); correlationID != "" {
+			span.SetAttributes(attribute.String("hcloud.correlation_id", correlationID))
+		}
+	}
+	setSpanStatus(span, err)
+
+	return resp, err
+}
+
+func wrapTracingAttemptHandler(wrapped handler, tracer trace.Tracer) handler {
+	return &tracingAttemptHandler{handler: wrapped, tracer: tracer}
+}
+
+// tracingAttemptHandler creates a child span for each attempt of an API operation.
+type tracingAttemptHandler struct {
+	handler handler
+	tracer  trace.Tracer
+}
+
+func (h *tracingAttemptHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	op, ok := req.Context().Value(tracingOperationKey{}).(*tracingOperation)
+	if !ok {
+		return h.handler.Do(req, v)
+	}
+	op.attempts++
+
+	ctx, span := h.tracer.Start(req.Context(), op.name+" attempt",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.Int("hcloud.attempt", op.attempts)),
+	)
+	defer span.End()
+
+	resp, err = h.handler.Do(req.WithContext(ctx), v)
+
+	span.SetAttributes(responseAttributes(resp, err)...)
+	setSpanStatus(span, err)
+
+	return resp, err
+}
+
+// responseAttributes returns the status code and error code of the response.
+func responseAttributes(resp *Response, err error) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if resp != nil && resp.Response != nil {
+		attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		attrs = append(attrs, attribute.String("hcloud.error_code", string(apiErr.Code)))
+	}
+	return attrs
+}
+
+// setSpanStatus sets the status of the span from the error.
+func setSpanStatus(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,157 @@
+package hcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	result := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		result[attr.Key] = attr.Value
+	}
+	return result
+}
+
+func TestClientTracing(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Correlation-Id", "abc123")
+		switch {
+		case r.URL.Path == "/servers/1" && calls == 1:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error": {"code": "conflict", "message": "conflict"}}`))
+		case r.URL.Path == "/servers/1":
+			_, _ = w.Write([]byte(`{"server": {"id": 1}}`))
+		case r.URL.Path == "/actions":
+			_, _ = w.Write([]byte(`{"actions": [{"id": 10, "status": "success", "command": "create_server"}], "meta": {"pagination": {"page": 1}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": "not_found", "message": "not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithPollOpts(PollOpts{BackoffFunc: ConstantBackoff(0)}),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+
+	ctx := context.Background()
+
+	t.Run("operation", func(t *testing.T) {
+		recorder.Reset()
+
+		result, _, err := client.Server.GetByID(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 3)
+
+		// Attempts end before the operation
+		attempt1, attempt2, operation := spans[0], spans[1], spans[2]
+
+		assert.Equal(t, "GET /servers/-", operation.Name())
+		assert.Equal(t, map[attribute.Key]attribute.Value{
+			"http.request.method":       attribute.StringValue("GET"),
+			"hcloud.operation":          attribute.StringValue("/servers/-"),
+			"http.response.status_code": attribute.IntValue(200),
+			"hcloud.retry_count":        attribute.IntValue(1),
+			"hcloud.correlation_id":     attribute.StringValue("abc123"),
+		}, spanAttributes(operation))
+
+		assert.Equal(t, "GET /servers/- attempt", attempt1.Name())
+		assert.Equal(t, operation.SpanContext().SpanID(), attempt1.Parent().SpanID())
+		assert.Equal(t, codes.Error, attempt1.Status().Code)
+		assert.Equal(t, map[attribute.Key]attribute.Value{
+			"hcloud.attempt":            attribute.IntValue(1),
+			"http.response.status_code": attribute.IntValue(409),
+			"hcloud.error_code":         attribute.StringValue("conflict"),
+		}, spanAttributes(attempt1))
+
+		assert.Equal(t, operation.SpanContext().SpanID(), attempt2.Parent().SpanID())
+		assert.Equal(t, codes.Unset, attempt2.Status().Code)
+		assert.Equal(t, attribute.IntValue(2), spanAttributes(attempt2)["hcloud.attempt"])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		recorder.Reset()
+
+		_, err := client.Server.Delete(ctx, &Server{ID: 2})
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+
+		operation := spans[1]
+		assert.Equal(t, "DELETE /servers/-", operation.Name())
+		assert.Equal(t, codes.Error, operation.Status().Code)
+		assert.Equal(t, attribute.StringValue("not_found"), spanAttributes(operation)["hcloud.error_code"])
+		assert.Equal(t, attribute.IntValue(0), spanAttributes(operation)["hcloud.retry_count"])
+	})
+
+	t.Run("wait for actions", func(t *testing.T) {
+		recorder.Reset()
+
+		err := client.Action.WaitFor(ctx, &Action{ID: 10, Status: ActionStatusRunning})
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 3)
+
+		wait := spans[2]
+		assert.Equal(t, "wait for actions", wait.Name())
+		assert.Equal(t, attribute.Int64SliceValue([]int64{10}), spanAttributes(wait)["hcloud.action.ids"])
+		assert.Equal(t, wait.SpanContext().SpanID(), spans[1].Parent().SpanID())
+
+		events := wait.Events()
+		require.Len(t, events, 2)
+		assert.Equal(t, "poll", events[0].Name)
+		assert.Equal(t, "action completed", events[1].Name)
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		var data metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &data))
+		require.Len(t, data.ScopeMetrics, 1)
+		assert.Equal(t, instrumentationName, data.ScopeMetrics[0].Scope.Name)
+
+		metrics := map[string]metricdata.Metrics{}
+		for _, m := range data.ScopeMetrics[0].Metrics {
+			metrics[m.Name] = m
+		}
+
+		requests, ok := metrics["hcloud.client.requests"].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+
+		total := int64(0)
+		for _, point := range requests.DataPoints {
+			total += point.Value
+		}
+		assert.Equal(t, int64(3), total)
+
+		duration, ok := metrics["hcloud.client.request.duration"].Data.(metricdata.Histogram[float64])
+		require.True(t, ok)
+		assert.Len(t, duration.DataPoints, 3)
+	})
+}
@@ -2,6 +2,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 	"strconv"
 )
 
@@ -36,6 +37,39 @@ func iterPages[T any](listFn func(int) ([]*T, *Response, error)) ([]*T, error) {
 	}
 }
 
+// iterPagesSeq returns an iterator that fetches each pages using the list function
+// while iterating, and yields each item. The iterator stops on the first error, which is
+// yielded with a nil item, or when the context is canceled.
+func iterPagesSeq[T any](ctx context.Context, listFn func(int) ([]*T, *Response, error)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		page := 1
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			pageResult, resp, err := listFn(page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, item := range pageResult {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if resp.Meta.Pagination == nil || resp.Meta.Pagination.NextPage == 0 {
+				return
+			}
+			page = resp.Meta.Pagination.NextPage
+		}
+	}
+}
+
 // firstBy fetches a list of items using the list function, and returns the first item
 // of the list if present otherwise nil.
 func firstBy[T any](listFn func() ([]*T, *Response, error)) (*T, *Response, error) {
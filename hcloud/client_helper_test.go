@@ -1,6 +1,7 @@
 package hcloud
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -30,3 +31,72 @@ func TestIterPages(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestIterPagesSeq(t *testing.T) {
+	listFn := func(page int) ([]*int, *Response, error) {
+		if page < 4 {
+			return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: &Pagination{NextPage: page + 1}}}, nil
+		}
+		return []*int{Ptr(page)}, &Response{}, nil
+	}
+
+	t.Run("succeed", func(t *testing.T) {
+		result := []*int{}
+		for item, err := range iterPagesSeq(context.Background(), listFn) {
+			require.NoError(t, err)
+			result = append(result, item)
+		}
+		require.Equal(t, []*int{Ptr(1), Ptr(2), Ptr(3), Ptr(4)}, result)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		result := []*int{}
+		var lastErr error
+		for item, err := range iterPagesSeq(context.Background(), func(page int) ([]*int, *Response, error) {
+			if page < 3 {
+				return listFn(page)
+			}
+			return nil, &Response{}, fmt.Errorf("failure")
+		}) {
+			if err != nil {
+				require.Nil(t, item)
+				lastErr = err
+				continue
+			}
+			result = append(result, item)
+		}
+		require.EqualError(t, lastErr, "failure")
+		require.Equal(t, []*int{Ptr(1), Ptr(2)}, result)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		pages := []int{}
+		for item := range iterPagesSeq(context.Background(), func(page int) ([]*int, *Response, error) {
+			pages = append(pages, page)
+			return listFn(page)
+		}) {
+			if *item == 2 {
+				break
+			}
+		}
+		require.Equal(t, []int{1, 2}, pages)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result := []*int{}
+		var lastErr error
+		for item, err := range iterPagesSeq(ctx, listFn) {
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			result = append(result, item)
+			cancel()
+		}
+		require.ErrorIs(t, lastErr, context.Canceled)
+		require.Equal(t, []*int{Ptr(1)}, result)
+	})
+}
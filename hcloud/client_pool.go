@@ -0,0 +1,198 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ClientPoolOpts specifies options for [NewClientPool].
+type ClientPoolOpts struct {
+	// HTTPClient is shared by all the clients of the pool, so they share the same
+	// transport and connections. Defaults to a new [http.Client].
+	HTTPClient *http.Client
+	// InstrumentationRegistry is shared by all the clients of the pool to collect
+	// metrics, see [WithInstrumentation].
+	InstrumentationRegistry prometheus.Registerer
+	// RateLimiterOpts configures the [RateLimiter] of each project.
+	RateLimiterOpts RateLimiterOpts
+	// ClientOptions are applied to all the clients of the pool.
+	ClientOptions []ClientOption
+}
+
+// ClientPool manages one [Client] per project, each client using the token of its
+// project. The clients share the same HTTP transport and instrumentation registry,
+// but each project has its own [RateLimiter].
+//
+// A ClientPool is safe for concurrent use.
+type ClientPool struct {
+	opts ClientPoolOpts
+
+	mu       sync.RWMutex
+	projects map[string]*Client
+}
+
+// NewClientPool creates a new empty [ClientPool].
+func NewClientPool(opts ClientPoolOpts) *ClientPool {
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{}
+	}
+	return &ClientPool{
+		opts:     opts,
+		projects: make(map[string]*Client),
+	}
+}
+
+// Add creates a client for the project using the given token, and returns it. An
+// existing client for the project is replaced.
+//
+// The options are applied after the options of the pool.
+func (p *ClientPool) Add(project, token string, options ...ClientOption) *Client {
+	// The instrumentation wraps the transport of the HTTP client, a shallow copy
+	// prevents the clients from wrapping the transport of each other.
+	httpClient := *p.opts.HTTPClient
+
+	clientOptions := slices.Clone(p.opts.ClientOptions)
+	clientOptions = append(clientOptions,
+		WithToken(token),
+		WithHTTPClient(&httpClient),
+		WithRateLimiter(NewRateLimiter(p.opts.RateLimiterOpts)),
+	)
+	if p.opts.InstrumentationRegistry != nil {
+		clientOptions = append(clientOptions, WithInstrumentation(p.opts.InstrumentationRegistry))
+	}
+	clientOptions = append(clientOptions, options...)
+
+	client := NewClient(clientOptions...)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.projects[project] = client
+	return client
+}
+
+// Remove removes the client of the project from the pool.
+func (p *ClientPool) Remove(project string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.projects, project)
+}
+
+// Client returns the client of the project, or nil if the project is not in the pool.
+func (p *ClientPool) Client(project string) *Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.projects[project]
+}
+
+// Projects returns the sorted names of the projects in the pool.
+func (p *ClientPool) Projects() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(p.projects))
+}
+
+// Ratelimit returns the rate limit information of the last API response received
+// for the project, see [RateLimiter.Ratelimit].
+func (p *ClientPool) Ratelimit(project string) (Ratelimit, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	client, ok := p.projects[project]
+	if !ok {
+		return Ratelimit{}, false
+	}
+	if client.rateLimiter == nil {
+		return Ratelimit{}, true
+	}
+	return client.rateLimiter.Ratelimit(), true
+}
+
+// clients returns a snapshot of the clients in the pool, by project.
+func (p *ClientPool) clients() map[string]*Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return maps.Clone(p.projects)
+}
+
+// ProjectItem is an item tagged with the project it belongs to.
+type ProjectItem[T any] struct {
+	Project string
+	Item    T
+}
+
+// PoolList runs the list function for all the projects of the pool concurrently,
+// and merges the results. The items are ordered by project, then in the order
+// returned by the list function.
+//
+// The items of the projects that succeeded are returned, along with the errors of
+// the projects that failed.
+//
+// Example:
+//
+//	servers, err := hcloud.PoolList(ctx, pool, func(ctx context.Context, client *hcloud.Client) ([]*hcloud.Server, error) {
+//		return client.Server.All(ctx)
+//	})
+func PoolList[T any](ctx context.Context, pool *ClientPool, list func(ctx context.Context, client *Client) ([]T, error)) ([]ProjectItem[T], error) {
+	clients := pool.clients()
+	projects := slices.Sorted(maps.Keys(clients))
+
+	results := make([][]T, len(projects))
+	errs := make([]error, len(projects))
+
+	wg := sync.WaitGroup{}
+	for i, project := range projects {
+		wg.Go(func() {
+			items, err := list(ctx, clients[project])
+			if err != nil {
+				errs[i] = fmt.Errorf("project %s: %w", project, err)
+				return
+			}
+			results[i] = items
+		})
+	}
+	wg.Wait()
+
+	merged := make([]ProjectItem[T], 0)
+	for i, project := range projects {
+		for _, item := range results[i] {
+			merged = append(merged, ProjectItem[T]{Project: project, Item: item})
+		}
+	}
+	return merged, errors.Join(errs...)
+}
+
+// PoolGet runs the get function for all the projects of the pool concurrently, and
+// merges the results. Zero values (e.g. a nil resource that was not found) are
+// skipped. The items are ordered by project.
+//
+// The items of the projects that succeeded are returned, along with the errors of
+// the projects that failed.
+//
+// Example:
+//
+//	servers, err := hcloud.PoolGet(ctx, pool, func(ctx context.Context, client *hcloud.Client) (*hcloud.Server, error) {
+//		server, _, err := client.Server.GetByName(ctx, "my-server")
+//		return server, err
+//	})
+func PoolGet[T comparable](ctx context.Context, pool *ClientPool, get func(ctx context.Context, client *Client) (T, error)) ([]ProjectItem[T], error) {
+	var zero T
+	return PoolList(ctx, pool, func(ctx context.Context, client *Client) ([]T, error) {
+		item, err := get(ctx, client)
+		if err != nil || item == zero {
+			return nil, err
+		}
+		return []T{item}, nil
+	})
+}
@@ -0,0 +1,118 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPool(t *testing.T) {
+	ctx := context.Background()
+
+	// The token is "token-<project>", and every project has a single server named
+	// after the project.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		project := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-")
+
+		w.Header().Set("Content-Type", "application/json")
+		if project == "broken" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": {"code": "unauthorized", "message": "unable to authenticate"}}`)
+			return
+		}
+
+		remaining := map[string]string{"alpha": "3599", "beta": "3500"}[project]
+		w.Header().Set("RateLimit-Limit", "3600")
+		w.Header().Set("RateLimit-Remaining", remaining)
+		w.Header().Set("RateLimit-Reset", "1735689600")
+
+		switch {
+		case r.URL.Path == "/servers" && r.URL.Query().Get("name") == "missing":
+			fmt.Fprint(w, `{"servers": []}`)
+		default:
+			fmt.Fprintf(w, `{"servers": [{"id": 1, "name": %q}], "meta": {"pagination": {"page": 1, "last_page": 1}}}`, project)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	registry := prometheus.NewRegistry()
+	pool := NewClientPool(ClientPoolOpts{
+		InstrumentationRegistry: registry,
+		ClientOptions: []ClientOption{
+			WithEndpoint(server.URL),
+			WithRetryOpts(RetryOpts{MaxRetries: 0}),
+		},
+	})
+	pool.Add("beta", "token-beta")
+	pool.Add("alpha", "token-alpha")
+
+	assert.Equal(t, []string{"alpha", "beta"}, pool.Projects())
+	assert.NotSame(t, pool.Client("alpha"), pool.Client("beta"))
+	assert.Nil(t, pool.Client("gamma"))
+
+	t.Run("list", func(t *testing.T) {
+		servers, err := PoolList(ctx, pool, func(ctx context.Context, client *Client) ([]*Server, error) {
+			return client.Server.All(ctx)
+		})
+		require.NoError(t, err)
+		require.Len(t, servers, 2)
+		assert.Equal(t, "alpha", servers[0].Project)
+		assert.Equal(t, "alpha", servers[0].Item.Name)
+		assert.Equal(t, "beta", servers[1].Project)
+		assert.Equal(t, "beta", servers[1].Item.Name)
+	})
+
+	t.Run("ratelimit", func(t *testing.T) {
+		ratelimit, ok := pool.Ratelimit("alpha")
+		require.True(t, ok)
+		assert.Equal(t, 3599, ratelimit.Remaining)
+
+		ratelimit, ok = pool.Ratelimit("beta")
+		require.True(t, ok)
+		assert.Equal(t, 3500, ratelimit.Remaining)
+
+		_, ok = pool.Ratelimit("gamma")
+		assert.False(t, ok)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		getByName := func(name string) func(ctx context.Context, client *Client) (*Server, error) {
+			return func(ctx context.Context, client *Client) (*Server, error) {
+				server, _, err := client.Server.GetByName(ctx, name)
+				return server, err
+			}
+		}
+
+		servers, err := PoolGet(ctx, pool, getByName("any"))
+		require.NoError(t, err)
+		assert.Len(t, servers, 2)
+
+		servers, err = PoolGet(ctx, pool, getByName("missing"))
+		require.NoError(t, err)
+		assert.Empty(t, servers)
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		pool.Add("broken", "token-broken")
+		defer pool.Remove("broken")
+
+		servers, err := PoolList(ctx, pool, func(ctx context.Context, client *Client) ([]*Server, error) {
+			return client.Server.All(ctx)
+		})
+		require.EqualError(t, err, "project broken: unable to authenticate (unauthorized)")
+		assert.Len(t, servers, 2)
+	})
+
+	t.Run("shared registry", func(t *testing.T) {
+		metrics, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotEmpty(t, metrics)
+	})
+}
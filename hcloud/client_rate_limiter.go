@@ -0,0 +1,162 @@
+package hcloud
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that delays API requests before the rate limit of a
+// token is exhausted.
+//
+// The capacity and the refill rate of the bucket are learned from the RateLimit-*
+// headers of the API responses (see [Ratelimit]). Until the first response with those
+// headers is received, requests are not delayed.
+//
+// A RateLimiter is safe for concurrent use, and may be shared between multiple [Client]
+// using the same token, see [WithRateLimiter].
+type RateLimiter struct {
+	mu sync.Mutex
+
+	// reserve is the number of requests that are kept in reserve.
+	reserve float64
+	// capacity is the maximum number of tokens in the bucket, 0 means unknown.
+	capacity float64
+	// tokens is the number of tokens left in the bucket, reservations for delayed
+	// requests may bring the bucket below zero.
+	tokens float64
+	// rate is the number of tokens added to the bucket per second.
+	rate float64
+	// last is the last time the bucket was refilled.
+	last time.Time
+	// ratelimit is the rate limit information of the last API response.
+	ratelimit Ratelimit
+
+	now func() time.Time
+}
+
+// RateLimiterOpts defines the options used by [NewRateLimiter].
+type RateLimiterOpts struct {
+	// Reserve is the number of requests kept in reserve, requests are delayed once
+	// the remaining requests reach this number. This leaves some room for other
+	// consumers of the same token.
+	Reserve int
+}
+
+// NewRateLimiter creates a new [RateLimiter] that can be shared between multiple
+// [Client] using the same token.
+func NewRateLimiter(opts RateLimiterOpts) *RateLimiter {
+	return &RateLimiter{
+		reserve: float64(max(opts.Reserve, 0)),
+		now:     time.Now,
+	}
+}
+
+// Wait blocks until a request is allowed to be sent, or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserveToken()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancelToken()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Ratelimit returns the rate limit information of the last API response, the zero
+// value is returned until a response with rate limit information is received.
+func (l *RateLimiter) Ratelimit() Ratelimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.ratelimit
+}
+
+// reserveToken takes a token from the bucket, and returns how long the caller must
+// wait before the token is available.
+func (l *RateLimiter) reserveToken() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.capacity == 0 {
+		return 0
+	}
+
+	l.refill()
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancelToken gives back a token that was reserved but not used.
+func (l *RateLimiter) cancelToken() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.capacity == 0 {
+		return
+	}
+
+	l.tokens++
+}
+
+// refill adds the tokens accumulated since the last refill to the bucket.
+func (l *RateLimiter) refill() {
+	now := l.now()
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens = math.Min(l.tokens+elapsed*l.rate, l.capacity-l.reserve)
+	}
+	l.last = now
+}
+
+// update adjusts the bucket using the rate limit information of an API response.
+func (l *RateLimiter) update(ratelimit Ratelimit) {
+	if ratelimit.Limit <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.ratelimit = ratelimit
+
+	now := l.now()
+	limit := float64(ratelimit.Limit)
+	remaining := float64(ratelimit.Remaining) - l.reserve
+
+	// The reset time is when the bucket will be full again, which gives us the rate
+	// at which the bucket is refilled.
+	if untilReset := ratelimit.Reset.Sub(now); untilReset >= time.Second && ratelimit.Remaining < ratelimit.Limit {
+		l.rate = float64(ratelimit.Limit-ratelimit.Remaining) / untilReset.Seconds()
+	}
+	if l.rate == 0 {
+		// Fallback to the documented rate limit of the Cloud API, which refills the
+		// whole bucket in one hour.
+		l.rate = limit / time.Hour.Seconds()
+	}
+
+	if l.capacity == 0 {
+		l.capacity = limit
+		l.tokens = remaining
+		l.last = now
+		return
+	}
+
+	l.refill()
+	l.capacity = limit
+	// The API is the source of truth, but our bucket also accounts for the requests
+	// that are in flight, or waiting for a token. Keep the most conservative value.
+	l.tokens = math.Min(l.tokens, remaining)
+}
@@ -0,0 +1,116 @@
+package hcloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(opts RateLimiterOpts) (*RateLimiter, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(opts)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("unknown limit", func(t *testing.T) {
+		limiter, _ := newTestRateLimiter(RateLimiterOpts{})
+
+		for range 10 {
+			assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		}
+	})
+
+	t.Run("remaining requests", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+		// 2 remaining requests, and 1 request per second is refilled
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 2, Reset: now.Add(3598 * time.Second)})
+
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Second, limiter.reserveToken())
+		assert.Equal(t, 2*time.Second, limiter.reserveToken())
+
+		// Tokens are refilled over time
+		*now = now.Add(5 * time.Second)
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+	})
+
+	t.Run("last rate limit", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+		assert.Equal(t, Ratelimit{}, limiter.Ratelimit())
+
+		ratelimit := Ratelimit{Limit: 3600, Remaining: 10, Reset: now.Add(time.Hour)}
+		limiter.update(ratelimit)
+		limiter.update(Ratelimit{})
+		assert.Equal(t, ratelimit, limiter.Ratelimit())
+	})
+
+	t.Run("reserve", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{Reserve: 2})
+
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 3, Reset: now.Add(3597 * time.Second)})
+
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Second, limiter.reserveToken())
+	})
+
+	t.Run("refill is capped", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+		limiter.update(Ratelimit{Limit: 2, Remaining: 0, Reset: now.Add(2 * time.Second)})
+
+		*now = now.Add(time.Hour)
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Second, limiter.reserveToken())
+	})
+
+	t.Run("update keeps the conservative value", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 1, Reset: now.Add(3599 * time.Second)})
+		assert.Equal(t, time.Duration(0), limiter.reserveToken())
+		assert.Equal(t, time.Second, limiter.reserveToken())
+
+		// The response of the first request does not know about the waiting request
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 0, Reset: now.Add(3600 * time.Second)})
+		assert.Equal(t, 2*time.Second, limiter.reserveToken())
+
+		// Another consumer of the token used the remaining requests
+		*now = now.Add(10 * time.Second)
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 0, Reset: now.Add(3600 * time.Second)})
+		assert.Equal(t, time.Second, limiter.reserveToken())
+	})
+
+	t.Run("wait canceled", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+		limiter.update(Ratelimit{Limit: 3600, Remaining: 0, Reset: now.Add(time.Hour)})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := limiter.Wait(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// The canceled reservation was given back
+		assert.Equal(t, time.Second, limiter.reserveToken())
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		limiter, now := newTestRateLimiter(RateLimiterOpts{})
+
+		// 1000 requests per second are refilled
+		limiter.update(Ratelimit{Limit: 10000, Remaining: 0, Reset: now.Add(10 * time.Second)})
+
+		start := time.Now()
+		require.NoError(t, limiter.Wait(context.Background()))
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -301,6 +302,48 @@ func TestClientDoPost(t *testing.T) {
 	}
 }
 
+func TestClientCache(t *testing.T) {
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET", Path: "/server_types/1",
+			Status:  200,
+			JSONRaw: `{ "server_type": { "id": 1, "name": "cpx22" }}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1 }}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1 }}`,
+		},
+	})
+
+	registry := prometheus.NewRegistry()
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithCache(CacheOpts{}),
+		WithInstrumentation(registry),
+	)
+
+	ctx := context.Background()
+	for range 2 {
+		serverType, _, err := client.ServerType.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "cpx22", serverType.Name)
+
+		result, _, err := client.Server.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+	}
+
+	count, err := testutil.GatherAndCount(registry, "hcloud_api_cache_hits_total", "hcloud_api_cache_misses_total")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 func TestExponentialBackoff(t *testing.T) {
 	t.Run("without jitter", func(t *testing.T) {
 		backoffFunc := ExponentialBackoffWithOpts(ExponentialBackoffOpts{
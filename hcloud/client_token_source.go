@@ -0,0 +1,119 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/envutil"
+)
+
+// TokenSource provides the token used to authenticate the API requests, see
+// [WithTokenSource].
+//
+// A TokenSource must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns the current token.
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenInvalidator may be implemented by a [TokenSource] that caches the token. When
+// the API rejects a token, InvalidateToken is called before the token is fetched again.
+type TokenInvalidator interface {
+	// InvalidateToken discards the cached token.
+	InvalidateToken()
+}
+
+// TokenSourceFunc is an adapter to use a function as a [TokenSource].
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token implements [TokenSource].
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a [TokenSource] that always returns the same token.
+func StaticTokenSource(token string) TokenSource {
+	return TokenSourceFunc(func(_ context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// EnvTokenSource returns a [TokenSource] that reads the token from the environment
+// variable named by the key (e.g. HCLOUD_TOKEN), or from the file located by the
+// environment variable named by the key + '_FILE' (e.g. HCLOUD_TOKEN_FILE).
+//
+// The token is read for every request, so changes to the file are picked up
+// immediately.
+func EnvTokenSource(key string) TokenSource {
+	return TokenSourceFunc(func(_ context.Context) (string, error) {
+		token, err := envutil.LookupEnvWithFile(key)
+		if err != nil {
+			return "", err
+		}
+		if token == "" {
+			return "", fmt.Errorf("missing token in environment variable: %s", key)
+		}
+		return token, nil
+	})
+}
+
+// FileTokenSource is a [TokenSource] that reads the token from a file, and reads it
+// again when the modification time or the size of the file changes. Leading and
+// trailing white spaces are removed from the token.
+type FileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileTokenSource creates a new [FileTokenSource] for the file located by path.
+func NewFileTokenSource(path string) *FileTokenSource {
+	return &FileTokenSource{path: path}
+}
+
+// Token implements [TokenSource].
+func (s *FileTokenSource) Token(_ context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", errors.New("missing token in token file: " + s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+
+	return s.token, nil
+}
+
+// InvalidateToken implements [TokenInvalidator].
+func (s *FileTokenSource) InvalidateToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+}
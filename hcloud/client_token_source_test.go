@@ -0,0 +1,183 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+func wantToken(token string) func(t *testing.T, r *http.Request) {
+	return func(t *testing.T, r *http.Request) {
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+	}
+}
+
+func TestClientWithTokenSource(t *testing.T) {
+	unauthorized := mockutil.Request{
+		Method: "GET", Path: "/servers/1",
+		Status:  401,
+		JSONRaw: `{ "error": { "code": "unauthorized", "message": "unable to authenticate" }}`,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+		client := NewClient(WithEndpoint(server.URL), WithToken("ignored"), WithTokenSource(StaticTokenSource("token")))
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers/1",
+				Want:    wantToken("token"),
+				Status:  200,
+				JSONRaw: `{ "server": { "id": 1 }}`,
+			},
+		})
+
+		result, _, err := client.Server.GetByID(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+	})
+
+	t.Run("refresh on unauthorized", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+
+		calls := atomic.Int32{}
+		source := TokenSourceFunc(func(_ context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				return "old", nil
+			}
+			return "new", nil
+		})
+		client := NewClient(WithEndpoint(server.URL), WithTokenSource(source))
+
+		first := unauthorized
+		first.Want = wantToken("old")
+		server.Expect([]mockutil.Request{
+			first,
+			{
+				Method: "GET", Path: "/servers/1",
+				Want:    wantToken("new"),
+				Status:  200,
+				JSONRaw: `{ "server": { "id": 1 }}`,
+			},
+		})
+
+		result, _, err := client.Server.GetByID(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+	})
+
+	t.Run("unchanged token", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+		client := NewClient(WithEndpoint(server.URL), WithTokenSource(StaticTokenSource("token")))
+
+		server.Expect([]mockutil.Request{unauthorized})
+
+		_, _, err := client.Server.GetByID(ctx, 1)
+		require.EqualError(t, err, "unable to authenticate (unauthorized)")
+	})
+
+	t.Run("refreshed token rejected", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+
+		calls := atomic.Int32{}
+		source := TokenSourceFunc(func(_ context.Context) (string, error) {
+			return fmt.Sprintf("token-%d", calls.Add(1)), nil
+		})
+		client := NewClient(WithEndpoint(server.URL), WithTokenSource(source))
+
+		// The request is only sent once again
+		server.Expect([]mockutil.Request{unauthorized, unauthorized})
+
+		_, _, err := client.Server.GetByID(ctx, 1)
+		require.EqualError(t, err, "unable to authenticate (unauthorized)")
+	})
+
+	t.Run("token error", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+		client := NewClient(WithEndpoint(server.URL), WithTokenSource(EnvTokenSource("HCLOUD_TEST_MISSING_TOKEN")))
+
+		_, _, err := client.Server.GetByID(ctx, 1)
+		require.EqualError(t, err, "failed to get authorization token: missing token in environment variable: HCLOUD_TEST_MISSING_TOKEN")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+		client := NewClient(WithEndpoint(server.URL), WithTokenSource(StaticTokenSource("token\n")))
+
+		_, _, err := client.Server.GetByID(ctx, 1)
+		require.EqualError(t, err, "authorization token contains invalid characters")
+	})
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	ctx := context.Background()
+	source := EnvTokenSource("HCLOUD_TEST_TOKEN")
+
+	t.Setenv("HCLOUD_TEST_TOKEN", "from-env")
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", token)
+
+	require.NoError(t, os.Unsetenv("HCLOUD_TEST_TOKEN"))
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+	t.Setenv("HCLOUD_TEST_TOKEN_FILE", path)
+
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "from-file", token)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "token")
+	source := NewFileTokenSource(path)
+
+	_, err := source.Token(ctx)
+	require.ErrorContains(t, err, "failed to read token file")
+
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	// The file is read again once it changed
+	require.NoError(t, os.WriteFile(path, []byte("second\n"), 0o600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "second", token)
+
+	// The cached token is used until the file changed, or the token is invalidated
+	require.NoError(t, os.WriteFile(path, []byte("update\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "second", token)
+
+	source.InvalidateToken()
+
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "update", token)
+
+	require.NoError(t, os.WriteFile(path, []byte(" \n"), 0o600))
+	_, err = source.Token(ctx)
+	require.EqualError(t, err, "missing token in token file: "+path)
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -149,3 +150,19 @@ func (c *DatacenterClient) AllWithOpts(ctx context.Context, opts DatacenterListO
 		return c.List(ctx, opts)
 	})
 }
+
+// Iter returns an iterator over all datacenters for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+//
+// Deprecated: [DatacenterClient.Iter] is deprecated and will be removed after the 2026-10-01. See
+// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+func (c *DatacenterClient) Iter(ctx context.Context, opts DatacenterListOpts) iter.Seq2[*Datacenter, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Datacenter, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
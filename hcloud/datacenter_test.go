@@ -270,4 +270,48 @@ func TestDatacenterClient(t *testing.T) {
 			t.Errorf("unexpected datacenters")
 		}
 	})
+
+	t.Run("Iter", func(t *testing.T) {
+		env := newTestEnv()
+		defer env.Teardown()
+
+		type listResponse struct {
+			Datacenters []schema.Datacenter `json:"datacenters"`
+			Meta        schema.Meta         `json:"meta"`
+		}
+
+		env.Mux.HandleFunc("/datacenters", func(w http.ResponseWriter, r *http.Request) {
+			if perPage := r.URL.Query().Get("per_page"); perPage != "50" {
+				t.Errorf("unexpected per_page: %s", perPage)
+			}
+			page := r.URL.Query().Get("page")
+			w.Header().Set("Content-Type", "application/json")
+			switch page {
+			case "1":
+				json.NewEncoder(w).Encode(listResponse{
+					Datacenters: []schema.Datacenter{{ID: 1}, {ID: 2}},
+					Meta:        schema.Meta{Pagination: &schema.MetaPagination{Page: 1, NextPage: 2}},
+				})
+			case "2":
+				json.NewEncoder(w).Encode(listResponse{
+					Datacenters: []schema.Datacenter{{ID: 3}},
+					Meta:        schema.Meta{Pagination: &schema.MetaPagination{Page: 2}},
+				})
+			default:
+				t.Errorf("unexpected page: %s", page)
+			}
+		})
+
+		ctx := context.Background()
+		ids := []int64{}
+		for datacenter, err := range env.Client.Datacenter.Iter(ctx, DatacenterListOpts{}) {
+			if err != nil {
+				t.Fatalf("Datacenter.Iter failed: %s", err)
+			}
+			ids = append(ids, datacenter.ID)
+		}
+		if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+			t.Errorf("unexpected datacenters: %v", ids)
+		}
+	})
 }
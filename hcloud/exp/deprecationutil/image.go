@@ -13,8 +13,7 @@ import (
 // Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
 func ImageMessage(image *hcloud.Image) (string, bool) {
 	if image.IsDeprecated() {
-		// Images are unavailable 3 months after the announcement
-		unavailableAfter := image.Deprecated.AddDate(0, 3, 0)
+		unavailableAfter := imageUnavailableAfter(image)
 
 		if time.Now().After(unavailableAfter) {
 			return fmt.Sprintf(
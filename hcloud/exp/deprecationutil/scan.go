@@ -0,0 +1,323 @@
+package deprecationutil
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// DatacenterUnavailableAfter is the date after which the datacenters API is removed.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+var DatacenterUnavailableAfter = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+// ResourceKind is the kind of a [Resource].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type ResourceKind string
+
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+const (
+	ResourceKindServer           ResourceKind = "server"
+	ResourceKindServerType       ResourceKind = "server_type"
+	ResourceKindLoadBalancer     ResourceKind = "load_balancer"
+	ResourceKindLoadBalancerType ResourceKind = "load_balancer_type"
+	ResourceKindImage            ResourceKind = "image"
+	ResourceKindDatacenter       ResourceKind = "datacenter"
+	ResourceKindLocation         ResourceKind = "location"
+	// ResourceKindAPI is the kind of the deprecated APIs, for example the datacenters API.
+	ResourceKindAPI ResourceKind = "api"
+)
+
+// Resource references a resource of the project.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Resource struct {
+	Kind ResourceKind
+	ID   int64
+	Name string
+}
+
+// String returns a human readable representation of the resource.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (o Resource) String() string {
+	return fmt.Sprintf("%s %q (%d)", o.Kind, o.Name, o.ID)
+}
+
+// Finding is a resource affected by a deprecation.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Finding struct {
+	// Resource is the affected resource.
+	Resource Resource
+	// Cause is the deprecated resource or API affecting the [Finding.Resource], for
+	// example its Server Type.
+	Cause Resource
+	// Message describes the deprecation of the [Finding.Cause].
+	Message string
+	// UnavailableAfter is the time after which the [Finding.Cause] is unavailable.
+	UnavailableAfter time.Time
+	// Unavailable is true when the [Finding.Cause] was already unavailable during the scan.
+	Unavailable bool
+	// Replacement is a suggested replacement for the [Finding.Cause], nil if none was found.
+	Replacement *Resource
+}
+
+// ScanOpts specifies options for [Scan].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type ScanOpts struct {
+	// DatacenterCatalog reports every datacenter of the catalogue returned by the
+	// deprecated datacenters API, whether or not the project uses it. The resources of
+	// the project no longer reference datacenters but locations, this is meant for
+	// programs still configured with datacenter names. The suggested replacement is the
+	// location of the datacenter.
+	DatacenterCatalog bool
+}
+
+// Scan lists the servers, load balancers and images in use in the project, and returns
+// the ones affected by a deprecation, sorted by [Finding.UnavailableAfter].
+//
+// Server Types are checked for a deprecation in all locations, and in the location of
+// each server. The suggested replacement Server Type has the same architecture and CPU
+// type, and at least as many cores, memory and disk, as the deprecated one. The
+// suggested replacement Load Balancer Type has at least the same limits as the
+// deprecated one.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Scan(ctx context.Context, client *hcloud.ClientFacade, opts ScanOpts) ([]Finding, error) {
+	now := time.Now()
+	findings := make([]Finding, 0)
+
+	servers, err := client.Server.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+	if len(servers) > 0 {
+		serverTypes, err := client.ServerType.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list server types: %w", err)
+		}
+		for _, server := range servers {
+			findings = append(findings, scanServer(server, serverTypes, now)...)
+		}
+	}
+
+	loadBalancers, err := client.LoadBalancer.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+	if len(loadBalancers) > 0 {
+		loadBalancerTypes, err := client.LoadBalancerType.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list load balancer types: %w", err)
+		}
+		for _, loadBalancer := range loadBalancers {
+			if finding, ok := scanLoadBalancer(loadBalancer, loadBalancerTypes, now); ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	if opts.DatacenterCatalog {
+		datacenters, err := client.Datacenter.All(ctx) // nolint:staticcheck // Reporting the usage of the deprecated API
+		if err != nil {
+			return nil, fmt.Errorf("failed to list datacenters: %w", err)
+		}
+		for _, datacenter := range datacenters {
+			findings = append(findings, datacenterFinding(datacenter, now))
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Or(
+			a.UnavailableAfter.Compare(b.UnavailableAfter),
+			cmp.Compare(a.Resource.Kind, b.Resource.Kind),
+			cmp.Compare(a.Resource.ID, b.Resource.ID),
+		)
+	})
+
+	return findings, nil
+}
+
+func scanServer(server *hcloud.Server, serverTypes []*hcloud.ServerType, now time.Time) []Finding {
+	resource := Resource{Kind: ResourceKindServer, ID: server.ID, Name: server.Name}
+	findings := make([]Finding, 0)
+
+	locationName := ""
+	if server.Location != nil {
+		locationName = server.Location.Name
+	}
+
+	if server.ServerType != nil {
+		serverType := server.ServerType
+		if i := slices.IndexFunc(serverTypes, func(o *hcloud.ServerType) bool { return o.ID == serverType.ID }); i >= 0 {
+			serverType = serverTypes[i]
+		}
+
+		if unavailableAfter, ok := serverTypeUnavailableAfter(serverType, locationName); ok {
+			message, _ := ServerTypeMessage(serverType, locationName)
+			finding := Finding{
+				Resource:         resource,
+				Cause:            Resource{Kind: ResourceKindServerType, ID: serverType.ID, Name: serverType.Name},
+				Message:          message,
+				UnavailableAfter: unavailableAfter,
+				Unavailable:      now.After(unavailableAfter),
+			}
+			if replacement := SuggestServerType(serverType, serverTypes, locationName); replacement != nil {
+				finding.Replacement = &Resource{Kind: ResourceKindServerType, ID: replacement.ID, Name: replacement.Name}
+			}
+			findings = append(findings, finding)
+		}
+	}
+
+	if server.Image != nil && server.Image.IsDeprecated() {
+		message, _ := ImageMessage(server.Image)
+		unavailableAfter := imageUnavailableAfter(server.Image)
+		findings = append(findings, Finding{
+			Resource:         resource,
+			Cause:            Resource{Kind: ResourceKindImage, ID: server.Image.ID, Name: server.Image.Name},
+			Message:          message,
+			UnavailableAfter: unavailableAfter,
+			Unavailable:      now.After(unavailableAfter),
+		})
+	}
+
+	return findings
+}
+
+func scanLoadBalancer(loadBalancer *hcloud.LoadBalancer, loadBalancerTypes []*hcloud.LoadBalancerType, now time.Time) (Finding, bool) {
+	if loadBalancer.LoadBalancerType == nil {
+		return Finding{}, false
+	}
+
+	lbType := loadBalancer.LoadBalancerType
+	if i := slices.IndexFunc(loadBalancerTypes, func(o *hcloud.LoadBalancerType) bool { return o.ID == lbType.ID }); i >= 0 {
+		lbType = loadBalancerTypes[i]
+	}
+	if !lbType.IsDeprecated() {
+		return Finding{}, false
+	}
+
+	message, _ := LoadBalancerTypeMessage(lbType)
+	finding := Finding{
+		Resource:         Resource{Kind: ResourceKindLoadBalancer, ID: loadBalancer.ID, Name: loadBalancer.Name},
+		Cause:            Resource{Kind: ResourceKindLoadBalancerType, ID: lbType.ID, Name: lbType.Name},
+		Message:          message,
+		UnavailableAfter: lbType.UnavailableAfter(),
+		Unavailable:      now.After(lbType.UnavailableAfter()),
+	}
+	if replacement := SuggestLoadBalancerType(lbType, loadBalancerTypes); replacement != nil {
+		finding.Replacement = &Resource{Kind: ResourceKindLoadBalancerType, ID: replacement.ID, Name: replacement.Name}
+	}
+	return finding, true
+}
+
+func datacenterFinding(datacenter *hcloud.Datacenter, now time.Time) Finding { // nolint:staticcheck // Reporting the usage of the deprecated API
+	finding := Finding{
+		Resource: Resource{Kind: ResourceKindDatacenter, ID: datacenter.ID, Name: datacenter.Name},
+		Cause:    Resource{Kind: ResourceKindAPI, Name: "datacenters"},
+		Message: fmt.Sprintf(
+			"Datacenter %q is deprecated and will be removed after %s",
+			datacenter.Name,
+			DatacenterUnavailableAfter.Format(time.DateOnly),
+		),
+		UnavailableAfter: DatacenterUnavailableAfter,
+		Unavailable:      now.After(DatacenterUnavailableAfter),
+	}
+	if datacenter.Location != nil {
+		finding.Replacement = &Resource{Kind: ResourceKindLocation, ID: datacenter.Location.ID, Name: datacenter.Location.Name}
+	}
+	return finding
+}
+
+// serverTypeUnavailableAfter returns the time after which the Server Type is unavailable
+// in all locations, or in the given location, and whether it is deprecated at all.
+func serverTypeUnavailableAfter(serverType *hcloud.ServerType, locationName string) (time.Time, bool) {
+	if serverType.IsDeprecated() {
+		return serverType.UnavailableAfter(), true
+	}
+	if i := slices.IndexFunc(serverType.Locations, func(o hcloud.ServerTypeLocation) bool {
+		return o.Location != nil && o.Location.Name == locationName
+	}); i >= 0 && serverType.Locations[i].IsDeprecated() {
+		return serverType.Locations[i].UnavailableAfter(), true
+	}
+	return time.Time{}, false
+}
+
+func imageUnavailableAfter(image *hcloud.Image) time.Time {
+	// Images are unavailable 3 months after the announcement
+	return image.Deprecated.AddDate(0, 3, 0)
+}
+
+// SuggestServerType returns the smallest Server Type of the candidates, that is not
+// deprecated in the location and can replace the given Server Type. The replacement has
+// the same architecture and CPU type, and at least as many cores, memory and disk. An
+// empty location name only considers deprecations in all locations. Returns nil if no
+// replacement is found.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func SuggestServerType(serverType *hcloud.ServerType, candidates []*hcloud.ServerType, locationName string) *hcloud.ServerType {
+	var result *hcloud.ServerType
+	for _, candidate := range candidates {
+		if candidate.ID == serverType.ID ||
+			candidate.Architecture != serverType.Architecture ||
+			candidate.CPUType != serverType.CPUType ||
+			candidate.Cores < serverType.Cores ||
+			candidate.Memory < serverType.Memory ||
+			candidate.Disk < serverType.Disk {
+			continue
+		}
+		if _, deprecated := serverTypeUnavailableAfter(candidate, locationName); deprecated {
+			continue
+		}
+		if locationName != "" && !slices.ContainsFunc(candidate.Locations, func(o hcloud.ServerTypeLocation) bool {
+			return o.Location != nil && o.Location.Name == locationName
+		}) {
+			continue
+		}
+
+		if result == nil || cmp.Or(
+			cmp.Compare(candidate.Cores, result.Cores),
+			cmp.Compare(candidate.Memory, result.Memory),
+			cmp.Compare(candidate.Disk, result.Disk),
+			cmp.Compare(candidate.ID, result.ID),
+		) < 0 {
+			result = candidate
+		}
+	}
+	return result
+}
+
+// SuggestLoadBalancerType returns the smallest Load Balancer Type of the candidates,
+// that is not deprecated and can replace the given Load Balancer Type. The replacement
+// has at least the same connections, services, targets and certificates limits.
+// Returns nil if no replacement is found.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func SuggestLoadBalancerType(lbType *hcloud.LoadBalancerType, candidates []*hcloud.LoadBalancerType) *hcloud.LoadBalancerType {
+	var result *hcloud.LoadBalancerType
+	for _, candidate := range candidates {
+		if candidate.ID == lbType.ID ||
+			candidate.IsDeprecated() ||
+			candidate.MaxConnections < lbType.MaxConnections ||
+			candidate.MaxServices < lbType.MaxServices ||
+			candidate.MaxTargets < lbType.MaxTargets ||
+			candidate.MaxAssignedCertificates < lbType.MaxAssignedCertificates {
+			continue
+		}
+
+		if result == nil || cmp.Or(
+			cmp.Compare(candidate.MaxConnections, result.MaxConnections),
+			cmp.Compare(candidate.ID, result.ID),
+		) < 0 {
+			result = candidate
+		}
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package deprecationutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/hcloudtest"
+)
+
+func TestScan(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	fsn1 := &hcloud.Location{ID: 1, Name: "fsn1"}
+	nbg1 := &hcloud.Location{ID: 2, Name: "nbg1"}
+
+	deprecation := func(unavailableAfter time.Time) hcloud.DeprecatableResource {
+		return hcloud.DeprecatableResource{Deprecation: &hcloud.DeprecationInfo{
+			Announced:        unavailableAfter.AddDate(0, -3, 0),
+			UnavailableAfter: unavailableAfter,
+		}}
+	}
+
+	cx22 := &hcloud.ServerType{
+		ID: 1, Name: "cx22", Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeShared,
+		Cores: 2, Memory: 4, Disk: 40,
+		Locations: []hcloud.ServerTypeLocation{
+			{Location: fsn1, DeprecatableResource: deprecation(now.AddDate(0, 2, 0))},
+			{Location: nbg1},
+		},
+	}
+	cx32 := &hcloud.ServerType{
+		ID: 2, Name: "cx32", Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeShared,
+		Cores: 4, Memory: 8, Disk: 80,
+		DeprecatableResource: deprecation(now.AddDate(0, 1, 0)),
+		Locations:            []hcloud.ServerTypeLocation{{Location: fsn1}, {Location: nbg1}},
+	}
+	cx23 := &hcloud.ServerType{
+		ID: 3, Name: "cx23", Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeShared,
+		Cores: 2, Memory: 4, Disk: 40,
+		Locations: []hcloud.ServerTypeLocation{{Location: fsn1}, {Location: nbg1}},
+	}
+	cx33 := &hcloud.ServerType{
+		ID: 4, Name: "cx33", Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeShared,
+		Cores: 4, Memory: 8, Disk: 80,
+		Locations: []hcloud.ServerTypeLocation{{Location: fsn1}, {Location: nbg1}},
+	}
+	cax21 := &hcloud.ServerType{
+		ID: 5, Name: "cax21", Architecture: hcloud.ArchitectureARM, CPUType: hcloud.CPUTypeShared,
+		Cores: 4, Memory: 8, Disk: 80,
+		Locations: []hcloud.ServerTypeLocation{{Location: fsn1}, {Location: nbg1}},
+	}
+
+	lb11 := &hcloud.LoadBalancerType{ID: 1, Name: "lb11", MaxConnections: 10000, MaxServices: 5, MaxTargets: 25, MaxAssignedCertificates: 10,
+		DeprecatableResource: deprecation(now.AddDate(0, 3, 0))}
+	lb12 := &hcloud.LoadBalancerType{ID: 2, Name: "lb12", MaxConnections: 10000, MaxServices: 5, MaxTargets: 25, MaxAssignedCertificates: 10}
+	lb21 := &hcloud.LoadBalancerType{ID: 3, Name: "lb21", MaxConnections: 20000, MaxServices: 15, MaxTargets: 75, MaxAssignedCertificates: 25}
+
+	image := &hcloud.Image{ID: 10, Name: "debian-11", Deprecated: now.AddDate(0, -4, 0)}
+
+	client := hcloudtest.NewClient()
+	client.Server.AllStub = func(_ context.Context) ([]*hcloud.Server, error) {
+		return []*hcloud.Server{
+			// Deprecated in fsn1 only, embedded server type without locations
+			{ID: 1, Name: "web1", Location: fsn1, ServerType: &hcloud.ServerType{ID: 1}, Image: &hcloud.Image{ID: 20}},
+			{ID: 2, Name: "web2", Location: nbg1, ServerType: &hcloud.ServerType{ID: 1}, Image: image},
+			{ID: 3, Name: "db1", Location: nbg1, ServerType: &hcloud.ServerType{ID: 2}},
+			{ID: 4, Name: "arm1", Location: nbg1, ServerType: &hcloud.ServerType{ID: 5}},
+		}, nil
+	}
+	client.ServerType.AllStub = func(_ context.Context) ([]*hcloud.ServerType, error) {
+		return []*hcloud.ServerType{cx22, cx32, cx23, cx33, cax21}, nil
+	}
+	client.LoadBalancer.AllStub = func(_ context.Context) ([]*hcloud.LoadBalancer, error) {
+		return []*hcloud.LoadBalancer{{ID: 1, Name: "lb", LoadBalancerType: &hcloud.LoadBalancerType{ID: 1}}}, nil
+	}
+	client.LoadBalancerType.AllStub = func(_ context.Context) ([]*hcloud.LoadBalancerType, error) {
+		return []*hcloud.LoadBalancerType{lb21, lb11, lb12}, nil
+	}
+	client.Datacenter.AllStub = func(_ context.Context) ([]*hcloud.Datacenter, error) {
+		return []*hcloud.Datacenter{{ID: 1, Name: "fsn1-dc14", Location: fsn1}}, nil
+	}
+
+	t.Run("findings", func(t *testing.T) {
+		findings, err := Scan(context.Background(), client.Facade(), ScanOpts{})
+		require.NoError(t, err)
+		require.Len(t, findings, 4)
+
+		assert.Equal(t, Resource{Kind: ResourceKindServer, ID: 2, Name: "web2"}, findings[0].Resource)
+		assert.Equal(t, Resource{Kind: ResourceKindImage, ID: 10, Name: "debian-11"}, findings[0].Cause)
+		assert.Equal(t, `Image "debian-11" is unavailable and can no longer be ordered`, findings[0].Message)
+		assert.True(t, findings[0].Unavailable)
+		assert.Nil(t, findings[0].Replacement)
+
+		assert.Equal(t, Resource{Kind: ResourceKindServer, ID: 3, Name: "db1"}, findings[1].Resource)
+		assert.Equal(t, Resource{Kind: ResourceKindServerType, ID: 2, Name: "cx32"}, findings[1].Cause)
+		assert.Equal(t, now.AddDate(0, 1, 0), findings[1].UnavailableAfter)
+		assert.False(t, findings[1].Unavailable)
+		assert.Equal(t, &Resource{Kind: ResourceKindServerType, ID: 4, Name: "cx33"}, findings[1].Replacement)
+
+		assert.Equal(t, Resource{Kind: ResourceKindServer, ID: 1, Name: "web1"}, findings[2].Resource)
+		assert.Equal(t, Resource{Kind: ResourceKindServerType, ID: 1, Name: "cx22"}, findings[2].Cause)
+		assert.Contains(t, findings[2].Message, `Server Type "cx22" is deprecated in "fsn1"`)
+		assert.Equal(t, now.AddDate(0, 2, 0), findings[2].UnavailableAfter)
+		assert.Equal(t, &Resource{Kind: ResourceKindServerType, ID: 3, Name: "cx23"}, findings[2].Replacement)
+
+		assert.Equal(t, Resource{Kind: ResourceKindLoadBalancer, ID: 1, Name: "lb"}, findings[3].Resource)
+		assert.Equal(t, Resource{Kind: ResourceKindLoadBalancerType, ID: 1, Name: "lb11"}, findings[3].Cause)
+		assert.Equal(t, &Resource{Kind: ResourceKindLoadBalancerType, ID: 2, Name: "lb12"}, findings[3].Replacement)
+
+		assert.Empty(t, client.Datacenter.Calls())
+	})
+
+	t.Run("datacenter catalog", func(t *testing.T) {
+		findings, err := Scan(context.Background(), client.Facade(), ScanOpts{DatacenterCatalog: true})
+		require.NoError(t, err)
+		require.Len(t, findings, 5)
+
+		finding := findings[4]
+		if now.After(DatacenterUnavailableAfter) {
+			finding = findings[1]
+		}
+		assert.Equal(t, Resource{Kind: ResourceKindDatacenter, ID: 1, Name: "fsn1-dc14"}, finding.Resource)
+		assert.Equal(t, Resource{Kind: ResourceKindAPI, Name: "datacenters"}, finding.Cause)
+		assert.Equal(t, `Datacenter "fsn1-dc14" is deprecated and will be removed after 2026-10-01`, finding.Message)
+		assert.Equal(t, &Resource{Kind: ResourceKindLocation, ID: 1, Name: "fsn1"}, finding.Replacement)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client := hcloudtest.NewClient()
+		client.Server.AllStub = func(_ context.Context) ([]*hcloud.Server, error) {
+			return nil, errors.New("boom")
+		}
+
+		_, err := Scan(context.Background(), client.Facade(), ScanOpts{})
+		require.EqualError(t, err, "failed to list servers: boom")
+	})
+}
+
+func TestSuggestServerType(t *testing.T) {
+	fsn1 := &hcloud.Location{Name: "fsn1"}
+	ccx13 := &hcloud.ServerType{ID: 1, Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeDedicated, Cores: 2, Memory: 8, Disk: 80}
+	cx53 := &hcloud.ServerType{ID: 2, Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeShared, Cores: 16, Memory: 32, Disk: 320,
+		Locations: []hcloud.ServerTypeLocation{{Location: fsn1}}}
+	ccx23 := &hcloud.ServerType{ID: 3, Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeDedicated, Cores: 4, Memory: 16, Disk: 160}
+	ccx33 := &hcloud.ServerType{ID: 4, Architecture: hcloud.ArchitectureX86, CPUType: hcloud.CPUTypeDedicated, Cores: 8, Memory: 32, Disk: 240,
+		Locations: []hcloud.ServerTypeLocation{{Location: fsn1}}}
+
+	candidates := []*hcloud.ServerType{ccx13, cx53, ccx33, ccx23}
+
+	assert.Equal(t, ccx23, SuggestServerType(ccx13, candidates, ""))
+	assert.Equal(t, ccx33, SuggestServerType(ccx13, candidates, "fsn1"))
+	assert.Nil(t, SuggestServerType(ccx33, candidates, ""))
+}
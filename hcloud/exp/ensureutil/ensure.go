@@ -0,0 +1,201 @@
+package ensureutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/labelutil"
+)
+
+// Opts configures how a resource is found and converged.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Opts struct {
+	// LabelKey finds the resource using the value of this label in the desired labels,
+	// instead of using the name. The name of the resource found is converged to the
+	// desired name.
+	LabelKey string
+	// Protection converges the protection of the resource. The protection is left
+	// untouched when nil.
+	Protection *Protection
+}
+
+// Protection is the desired protection of a resource. Rebuild is only supported by
+// servers.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Protection struct {
+	Delete  bool
+	Rebuild bool
+}
+
+// Result is the result of an ensure operation.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Result[T any] struct {
+	// Resource is the resource in its converged state.
+	Resource T
+	// Created reports whether the resource was created.
+	Created bool
+	// Updated reports whether the name, the labels or the protection of the resource
+	// were changed.
+	Updated bool
+}
+
+// Changed reports whether the resource was created or updated.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r Result[T]) Changed() bool {
+	return r.Created || r.Updated
+}
+
+// state holds the properties of a resource that are converged.
+type state struct {
+	name              string
+	labels            map[string]string
+	deleteProtection  bool
+	rebuildProtection bool
+}
+
+// resource describes how to find, create and update a resource of type T.
+type resource[T comparable] struct {
+	kind string
+
+	getByName func(ctx context.Context, name string) (T, error)
+	list      func(ctx context.Context, labelSelector string) ([]T, error)
+	create    func(ctx context.Context) (T, []*hcloud.Action, error)
+	update    func(ctx context.Context, o T, name string, labels map[string]string) (T, error)
+	state     func(o T) state
+
+	// changeProtection is nil when the resource does not support protection.
+	changeProtection func(ctx context.Context, o T, protection Protection) (*hcloud.Action, error)
+	// rebuildProtection reports whether the resource supports the rebuild protection.
+	rebuildProtection bool
+}
+
+func ensure[T comparable](ctx context.Context, client *hcloud.Client, r resource[T], desired state, opts Opts) (Result[T], error) {
+	var zero T
+	result := Result[T]{}
+
+	if opts.Protection != nil {
+		if r.changeProtection == nil {
+			return result, fmt.Errorf("%s does not support protection", r.kind)
+		}
+		if opts.Protection.Rebuild && !r.rebuildProtection {
+			return result, fmt.Errorf("%s does not support rebuild protection", r.kind)
+		}
+	}
+
+	current, err := find(ctx, r, desired, opts)
+	if err != nil {
+		return result, err
+	}
+
+	if current == zero {
+		created, actions, createErr := r.create(ctx)
+		switch {
+		case createErr == nil:
+			if err := waitFor(ctx, client, actions...); err != nil {
+				return result, err
+			}
+			current = created
+			result.Created = true
+		case hcloud.IsError(createErr, hcloud.ErrorCodeUniquenessError):
+			// Another worker created the resource in the meantime, converge the resource
+			// it created instead.
+			current, err = find(ctx, r, desired, opts)
+			if err != nil {
+				return result, err
+			}
+			if current == zero {
+				return result, fmt.Errorf("create %s: %w", r.kind, createErr)
+			}
+		default:
+			return result, fmt.Errorf("create %s: %w", r.kind, createErr)
+		}
+	}
+
+	currentState := r.state(current)
+
+	name := ""
+	if desired.name != "" && desired.name != currentState.name {
+		name = desired.name
+	}
+	var labels map[string]string
+	if desired.labels != nil && !maps.Equal(desired.labels, currentState.labels) {
+		labels = desired.labels
+	}
+	if name != "" || labels != nil {
+		current, err = r.update(ctx, current, name, labels)
+		if err != nil {
+			return result, fmt.Errorf("update %s: %w", r.kind, err)
+		}
+		result.Updated = true
+	}
+
+	if opts.Protection != nil &&
+		(opts.Protection.Delete != currentState.deleteProtection || opts.Protection.Rebuild != currentState.rebuildProtection) {
+		action, err := r.changeProtection(ctx, current, *opts.Protection)
+		if err != nil {
+			return result, fmt.Errorf("change %s protection: %w", r.kind, err)
+		}
+		if err := waitFor(ctx, client, action); err != nil {
+			return result, err
+		}
+		result.Updated = true
+	}
+
+	result.Resource = current
+	return result, nil
+}
+
+func find[T comparable](ctx context.Context, r resource[T], desired state, opts Opts) (T, error) {
+	var zero T
+
+	if opts.LabelKey == "" {
+		if desired.name == "" {
+			return zero, errors.New("missing name")
+		}
+		o, err := r.getByName(ctx, desired.name)
+		if err != nil {
+			return zero, fmt.Errorf("get %s: %w", r.kind, err)
+		}
+		return o, nil
+	}
+
+	value, ok := desired.labels[opts.LabelKey]
+	if !ok {
+		return zero, fmt.Errorf("missing label in the desired labels: %s", opts.LabelKey)
+	}
+
+	labelSelector := labelutil.NewExpr().Equals(opts.LabelKey, value).String()
+	items, err := r.list(ctx, labelSelector)
+	if err != nil {
+		return zero, fmt.Errorf("list %s: %w", r.kind, err)
+	}
+
+	switch len(items) {
+	case 0:
+		return zero, nil
+	case 1:
+		return items[0], nil
+	default:
+		return zero, fmt.Errorf("found %d %ss matching the label selector: %s", len(items), r.kind, labelSelector)
+	}
+}
+
+func waitFor(ctx context.Context, client *hcloud.Client, actions ...*hcloud.Action) error {
+	pending := make([]*hcloud.Action, 0, len(actions))
+	for _, action := range actions {
+		if action != nil {
+			pending = append(pending, action)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	return client.Action.WaitFor(ctx, pending...)
+}
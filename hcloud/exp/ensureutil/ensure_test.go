@@ -0,0 +1,171 @@
+package ensureutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/fakeutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+func newClient(endpoint string) *hcloud.Client {
+	return hcloud.NewClient(
+		hcloud.WithEndpoint(endpoint),
+		hcloud.WithToken("token"),
+		hcloud.WithRetryOpts(hcloud.RetryOpts{MaxRetries: 0}),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(time.Millisecond)}),
+	)
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(fakeutil.NewServer(t).URL)
+
+	opts := hcloud.ServerCreateOpts{
+		Name:       "web-1",
+		ServerType: &hcloud.ServerType{Name: "cpx22"},
+		Image:      &hcloud.Image{Name: "ubuntu-24.04"},
+		Location:   &hcloud.Location{Name: "fsn1"},
+		Labels:     map[string]string{"role": "web"},
+	}
+
+	result, err := Server(ctx, client, opts, Opts{})
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	assert.False(t, result.Updated)
+	id := result.Resource.ID
+
+	result, err = Server(ctx, client, opts, Opts{})
+	require.NoError(t, err)
+	assert.False(t, result.Changed())
+	assert.Equal(t, id, result.Resource.ID)
+
+	opts.Labels = map[string]string{"role": "web", "env": "prod"}
+	protection := &Protection{Delete: true, Rebuild: true}
+
+	result, err = Server(ctx, client, opts, Opts{Protection: protection})
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.True(t, result.Updated)
+	assert.Equal(t, id, result.Resource.ID)
+	assert.Equal(t, opts.Labels, result.Resource.Labels)
+	assert.Equal(t, hcloud.ServerProtection{Delete: true, Rebuild: true}, result.Resource.Protection)
+
+	result, err = Server(ctx, client, opts, Opts{Protection: protection})
+	require.NoError(t, err)
+	assert.False(t, result.Changed())
+	assert.Equal(t, hcloud.ServerProtection{Delete: true, Rebuild: true}, result.Resource.Protection)
+}
+
+func TestVolumeByLabelKey(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(fakeutil.NewServer(t).URL)
+
+	opts := hcloud.VolumeCreateOpts{
+		Name:     "data",
+		Size:     10,
+		Location: &hcloud.Location{Name: "nbg1"},
+		Labels:   map[string]string{"app": "db"},
+	}
+
+	result, err := Volume(ctx, client, opts, Opts{LabelKey: "app", Protection: &Protection{Delete: true}})
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	assert.True(t, result.Resource.Protection.Delete)
+	id := result.Resource.ID
+
+	opts.Name = "db-data"
+
+	result, err = Volume(ctx, client, opts, Opts{LabelKey: "app", Protection: &Protection{Delete: true}})
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.True(t, result.Updated)
+	assert.Equal(t, id, result.Resource.ID)
+	assert.Equal(t, "db-data", result.Resource.Name)
+
+	// The volume must not be found by name
+	opts.Labels = map[string]string{"app": "cache"}
+
+	_, err = Volume(ctx, client, opts, Opts{LabelKey: "app"})
+	require.EqualError(t, err, "create volume: name is already used (uniqueness_error)")
+}
+
+func TestEnsureErrors(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(fakeutil.NewServer(t).URL)
+
+	_, err := Firewall(ctx, client, hcloud.FirewallCreateOpts{Name: "fw"}, Opts{Protection: &Protection{Delete: true}})
+	require.EqualError(t, err, "firewall does not support protection")
+
+	_, err = Volume(ctx, client, hcloud.VolumeCreateOpts{Name: "data"}, Opts{Protection: &Protection{Rebuild: true}})
+	require.EqualError(t, err, "volume does not support rebuild protection")
+
+	_, err = Network(ctx, client, hcloud.NetworkCreateOpts{Name: "net"}, Opts{LabelKey: "app"})
+	require.EqualError(t, err, "missing label in the desired labels: app")
+
+	_, err = Network(ctx, client, hcloud.NetworkCreateOpts{}, Opts{})
+	require.EqualError(t, err, "missing name")
+}
+
+func TestUniquenessRace(t *testing.T) {
+	ctx := context.Background()
+
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET", Path: "/placement_groups?name=spread",
+			Status:  200,
+			JSONRaw: `{ "placement_groups": [] }`,
+		},
+		{
+			Method: "POST", Path: "/placement_groups",
+			Status:  409,
+			JSONRaw: `{ "error": { "code": "uniqueness_error", "message": "name is already used" }}`,
+		},
+		{
+			Method: "GET", Path: "/placement_groups?name=spread",
+			Status:  200,
+			JSONRaw: `{ "placement_groups": [{ "id": 1, "name": "spread", "labels": {}, "type": "spread" }] }`,
+		},
+		{
+			Method: "PUT", Path: "/placement_groups/1",
+			Status:  200,
+			JSONRaw: `{ "placement_group": { "id": 1, "name": "spread", "labels": { "env": "prod" }, "type": "spread" }}`,
+		},
+	})
+	client := newClient(server.URL)
+
+	result, err := PlacementGroup(ctx, client, hcloud.PlacementGroupCreateOpts{
+		Name:   "spread",
+		Type:   hcloud.PlacementGroupTypeSpread,
+		Labels: map[string]string{"env": "prod"},
+	}, Opts{})
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.True(t, result.Updated)
+	assert.Equal(t, int64(1), result.Resource.ID)
+	assert.Equal(t, map[string]string{"env": "prod"}, result.Resource.Labels)
+}
+
+func TestMultipleMatches(t *testing.T) {
+	ctx := context.Background()
+
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET", Path: "/certificates?label_selector=app%3Dweb&page=1&per_page=50",
+			Status:  200,
+			JSONRaw: `{ "certificates": [{ "id": 1 }, { "id": 2 }] }`,
+		},
+	})
+	client := newClient(server.URL)
+
+	_, err := Certificate(ctx, client, hcloud.CertificateCreateOpts{
+		Name:   "web",
+		Labels: map[string]string{"app": "web"},
+	}, Opts{LabelKey: "app"})
+	require.EqualError(t, err, "found 2 certificates matching the label selector: app=web")
+}
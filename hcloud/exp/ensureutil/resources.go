@@ -0,0 +1,332 @@
+package ensureutil
+
+import (
+	"context"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/actionutil"
+)
+
+// Server ensures a server matching the create options exists, and converges its
+// name, labels and protection. The other properties of an existing server are not
+// compared with the create options.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Server(ctx context.Context, client *hcloud.Client, opts hcloud.ServerCreateOpts, ensureOpts Opts) (Result[*hcloud.Server], error) {
+	return ensure(ctx, client, resource[*hcloud.Server]{
+		kind: "server",
+		getByName: func(ctx context.Context, name string) (*hcloud.Server, error) {
+			o, _, err := client.Server.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.Server, error) {
+			return client.Server.AllWithOpts(ctx, hcloud.ServerListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.Server, []*hcloud.Action, error) {
+			result, _, err := client.Server.Create(ctx, opts)
+			return result.Server, actionutil.AppendNext(result.Action, result.NextActions), err
+		},
+		update: func(ctx context.Context, o *hcloud.Server, name string, labels map[string]string) (*hcloud.Server, error) {
+			o, _, err := client.Server.Update(ctx, o, hcloud.ServerUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.Server) state {
+			return state{name: o.Name, labels: o.Labels, deleteProtection: o.Protection.Delete, rebuildProtection: o.Protection.Rebuild}
+		},
+		changeProtection: func(ctx context.Context, o *hcloud.Server, protection Protection) (*hcloud.Action, error) {
+			action, _, err := client.Server.ChangeProtection(ctx, o, hcloud.ServerChangeProtectionOpts{
+				Delete:  hcloud.Ptr(protection.Delete),
+				Rebuild: hcloud.Ptr(protection.Rebuild),
+			})
+			if err == nil {
+				o.Protection = hcloud.ServerProtection{Delete: protection.Delete, Rebuild: protection.Rebuild}
+			}
+			return action, err
+		},
+		rebuildProtection: true,
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// Volume ensures a volume matching the create options exists, and converges its
+// name, labels and protection. The other properties of an existing volume are not
+// compared with the create options.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Volume(ctx context.Context, client *hcloud.Client, opts hcloud.VolumeCreateOpts, ensureOpts Opts) (Result[*hcloud.Volume], error) {
+	return ensure(ctx, client, resource[*hcloud.Volume]{
+		kind: "volume",
+		getByName: func(ctx context.Context, name string) (*hcloud.Volume, error) {
+			o, _, err := client.Volume.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.Volume, error) {
+			return client.Volume.AllWithOpts(ctx, hcloud.VolumeListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.Volume, []*hcloud.Action, error) {
+			result, _, err := client.Volume.Create(ctx, opts)
+			return result.Volume, actionutil.AppendNext(result.Action, result.NextActions), err
+		},
+		update: func(ctx context.Context, o *hcloud.Volume, name string, labels map[string]string) (*hcloud.Volume, error) {
+			o, _, err := client.Volume.Update(ctx, o, hcloud.VolumeUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.Volume) state {
+			return state{name: o.Name, labels: o.Labels, deleteProtection: o.Protection.Delete}
+		},
+		changeProtection: func(ctx context.Context, o *hcloud.Volume, protection Protection) (*hcloud.Action, error) {
+			action, _, err := client.Volume.ChangeProtection(ctx, o, hcloud.VolumeChangeProtectionOpts{
+				Delete: hcloud.Ptr(protection.Delete),
+			})
+			if err == nil {
+				o.Protection = hcloud.VolumeProtection{Delete: protection.Delete}
+			}
+			return action, err
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// Network ensures a network matching the create options exists, and converges its
+// name, labels and protection. The other properties of an existing network are not
+// compared with the create options.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Network(ctx context.Context, client *hcloud.Client, opts hcloud.NetworkCreateOpts, ensureOpts Opts) (Result[*hcloud.Network], error) {
+	return ensure(ctx, client, resource[*hcloud.Network]{
+		kind: "network",
+		getByName: func(ctx context.Context, name string) (*hcloud.Network, error) {
+			o, _, err := client.Network.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.Network, error) {
+			return client.Network.AllWithOpts(ctx, hcloud.NetworkListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.Network, []*hcloud.Action, error) {
+			o, _, err := client.Network.Create(ctx, opts)
+			return o, nil, err
+		},
+		update: func(ctx context.Context, o *hcloud.Network, name string, labels map[string]string) (*hcloud.Network, error) {
+			o, _, err := client.Network.Update(ctx, o, hcloud.NetworkUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.Network) state {
+			return state{name: o.Name, labels: o.Labels, deleteProtection: o.Protection.Delete}
+		},
+		changeProtection: func(ctx context.Context, o *hcloud.Network, protection Protection) (*hcloud.Action, error) {
+			action, _, err := client.Network.ChangeProtection(ctx, o, hcloud.NetworkChangeProtectionOpts{
+				Delete: hcloud.Ptr(protection.Delete),
+			})
+			if err == nil {
+				o.Protection = hcloud.NetworkProtection{Delete: protection.Delete}
+			}
+			return action, err
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// Firewall ensures a firewall matching the create options exists, and converges its
+// name and labels. The rules and resources of an existing firewall are not compared
+// with the create options, use [hcloud.FirewallClient.Reconcile] to converge them.
+//
+// Firewalls do not support protection.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Firewall(ctx context.Context, client *hcloud.Client, opts hcloud.FirewallCreateOpts, ensureOpts Opts) (Result[*hcloud.Firewall], error) {
+	return ensure(ctx, client, resource[*hcloud.Firewall]{
+		kind: "firewall",
+		getByName: func(ctx context.Context, name string) (*hcloud.Firewall, error) {
+			o, _, err := client.Firewall.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.Firewall, error) {
+			return client.Firewall.AllWithOpts(ctx, hcloud.FirewallListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.Firewall, []*hcloud.Action, error) {
+			result, _, err := client.Firewall.Create(ctx, opts)
+			return result.Firewall, result.Actions, err
+		},
+		update: func(ctx context.Context, o *hcloud.Firewall, name string, labels map[string]string) (*hcloud.Firewall, error) {
+			o, _, err := client.Firewall.Update(ctx, o, hcloud.FirewallUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.Firewall) state {
+			return state{name: o.Name, labels: o.Labels}
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// SSHKey ensures a SSH key matching the create options exists, and converges its
+// name and labels. The public key of an existing SSH key is not compared with the
+// create options.
+//
+// SSH keys do not support protection.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func SSHKey(ctx context.Context, client *hcloud.Client, opts hcloud.SSHKeyCreateOpts, ensureOpts Opts) (Result[*hcloud.SSHKey], error) {
+	return ensure(ctx, client, resource[*hcloud.SSHKey]{
+		kind: "ssh key",
+		getByName: func(ctx context.Context, name string) (*hcloud.SSHKey, error) {
+			o, _, err := client.SSHKey.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.SSHKey, error) {
+			return client.SSHKey.AllWithOpts(ctx, hcloud.SSHKeyListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.SSHKey, []*hcloud.Action, error) {
+			o, _, err := client.SSHKey.Create(ctx, opts)
+			return o, nil, err
+		},
+		update: func(ctx context.Context, o *hcloud.SSHKey, name string, labels map[string]string) (*hcloud.SSHKey, error) {
+			o, _, err := client.SSHKey.Update(ctx, o, hcloud.SSHKeyUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.SSHKey) state {
+			return state{name: o.Name, labels: o.Labels}
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// PlacementGroup ensures a placement group matching the create options exists, and
+// converges its name and labels. The type of an existing placement group is not
+// compared with the create options.
+//
+// Placement groups do not support protection.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func PlacementGroup(ctx context.Context, client *hcloud.Client, opts hcloud.PlacementGroupCreateOpts, ensureOpts Opts) (Result[*hcloud.PlacementGroup], error) {
+	return ensure(ctx, client, resource[*hcloud.PlacementGroup]{
+		kind: "placement group",
+		getByName: func(ctx context.Context, name string) (*hcloud.PlacementGroup, error) {
+			o, _, err := client.PlacementGroup.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.PlacementGroup, error) {
+			return client.PlacementGroup.AllWithOpts(ctx, hcloud.PlacementGroupListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.PlacementGroup, []*hcloud.Action, error) {
+			result, _, err := client.PlacementGroup.Create(ctx, opts)
+			return result.PlacementGroup, []*hcloud.Action{result.Action}, err
+		},
+		update: func(ctx context.Context, o *hcloud.PlacementGroup, name string, labels map[string]string) (*hcloud.PlacementGroup, error) {
+			o, _, err := client.PlacementGroup.Update(ctx, o, hcloud.PlacementGroupUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.PlacementGroup) state {
+			return state{name: o.Name, labels: o.Labels}
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// PrimaryIP ensures a primary IP matching the create options exists, and converges
+// its name, labels and protection. The other properties of an existing primary IP
+// are not compared with the create options.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func PrimaryIP(ctx context.Context, client *hcloud.Client, opts hcloud.PrimaryIPCreateOpts, ensureOpts Opts) (Result[*hcloud.PrimaryIP], error) {
+	return ensure(ctx, client, resource[*hcloud.PrimaryIP]{
+		kind: "primary ip",
+		getByName: func(ctx context.Context, name string) (*hcloud.PrimaryIP, error) {
+			o, _, err := client.PrimaryIP.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.PrimaryIP, error) {
+			return client.PrimaryIP.AllWithOpts(ctx, hcloud.PrimaryIPListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.PrimaryIP, []*hcloud.Action, error) {
+			result, _, err := client.PrimaryIP.Create(ctx, opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			return result.PrimaryIP, []*hcloud.Action{result.Action}, nil
+		},
+		update: func(ctx context.Context, o *hcloud.PrimaryIP, name string, labels map[string]string) (*hcloud.PrimaryIP, error) {
+			updateOpts := hcloud.PrimaryIPUpdateOpts{Name: name}
+			if labels != nil {
+				updateOpts.Labels = &labels
+			}
+			o, _, err := client.PrimaryIP.Update(ctx, o, updateOpts)
+			return o, err
+		},
+		state: func(o *hcloud.PrimaryIP) state {
+			return state{name: o.Name, labels: o.Labels, deleteProtection: o.Protection.Delete}
+		},
+		changeProtection: func(ctx context.Context, o *hcloud.PrimaryIP, protection Protection) (*hcloud.Action, error) {
+			action, _, err := client.PrimaryIP.ChangeProtection(ctx, hcloud.PrimaryIPChangeProtectionOpts{
+				ID:     o.ID,
+				Delete: protection.Delete,
+			})
+			if err == nil {
+				o.Protection = hcloud.PrimaryIPProtection{Delete: protection.Delete}
+			}
+			return action, err
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// Certificate ensures a certificate matching the create options exists, and
+// converges its name and labels. The other properties of an existing certificate are
+// not compared with the create options.
+//
+// Certificates do not support protection.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Certificate(ctx context.Context, client *hcloud.Client, opts hcloud.CertificateCreateOpts, ensureOpts Opts) (Result[*hcloud.Certificate], error) {
+	return ensure(ctx, client, resource[*hcloud.Certificate]{
+		kind: "certificate",
+		getByName: func(ctx context.Context, name string) (*hcloud.Certificate, error) {
+			o, _, err := client.Certificate.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.Certificate, error) {
+			return client.Certificate.AllWithOpts(ctx, hcloud.CertificateListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.Certificate, []*hcloud.Action, error) {
+			result, _, err := client.Certificate.CreateCertificate(ctx, opts)
+			return result.Certificate, []*hcloud.Action{result.Action}, err
+		},
+		update: func(ctx context.Context, o *hcloud.Certificate, name string, labels map[string]string) (*hcloud.Certificate, error) {
+			o, _, err := client.Certificate.Update(ctx, o, hcloud.CertificateUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.Certificate) state {
+			return state{name: o.Name, labels: o.Labels}
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
+
+// LoadBalancer ensures a Load Balancer matching the create options exists, and
+// converges its name, labels and protection. The other properties of an existing
+// Load Balancer are not compared with the create options.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func LoadBalancer(ctx context.Context, client *hcloud.Client, opts hcloud.LoadBalancerCreateOpts, ensureOpts Opts) (Result[*hcloud.LoadBalancer], error) {
+	return ensure(ctx, client, resource[*hcloud.LoadBalancer]{
+		kind: "load balancer",
+		getByName: func(ctx context.Context, name string) (*hcloud.LoadBalancer, error) {
+			o, _, err := client.LoadBalancer.GetByName(ctx, name)
+			return o, err
+		},
+		list: func(ctx context.Context, labelSelector string) ([]*hcloud.LoadBalancer, error) {
+			return client.LoadBalancer.AllWithOpts(ctx, hcloud.LoadBalancerListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		create: func(ctx context.Context) (*hcloud.LoadBalancer, []*hcloud.Action, error) {
+			result, _, err := client.LoadBalancer.Create(ctx, opts)
+			return result.LoadBalancer, []*hcloud.Action{result.Action}, err
+		},
+		update: func(ctx context.Context, o *hcloud.LoadBalancer, name string, labels map[string]string) (*hcloud.LoadBalancer, error) {
+			o, _, err := client.LoadBalancer.Update(ctx, o, hcloud.LoadBalancerUpdateOpts{Name: name, Labels: labels})
+			return o, err
+		},
+		state: func(o *hcloud.LoadBalancer) state {
+			return state{name: o.Name, labels: o.Labels, deleteProtection: o.Protection.Delete}
+		},
+		changeProtection: func(ctx context.Context, o *hcloud.LoadBalancer, protection Protection) (*hcloud.Action, error) {
+			action, _, err := client.LoadBalancer.ChangeProtection(ctx, o, hcloud.LoadBalancerChangeProtectionOpts{
+				Delete: hcloud.Ptr(protection.Delete),
+			})
+			if err == nil {
+				o.Protection = hcloud.LoadBalancerProtection{Delete: protection.Delete}
+			}
+			return action, err
+		},
+	}, state{name: opts.Name, labels: opts.Labels}, ensureOpts)
+}
@@ -0,0 +1,203 @@
+package fakeutil
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// action is a stored action, with the state required to transition it to a final
+// status.
+type action struct {
+	schema.Action
+
+	// reads is the number of times the action was returned while running.
+	reads int
+	// fail holds the error to set when the action completes, if any.
+	fail *schema.ActionError
+	// onSuccess is called once the action succeeded, to apply delayed side effects.
+	onSuccess func()
+}
+
+// ref returns a reference to a resource, to use in [schema.Action.Resources].
+func ref(typ string, id int64) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: id, Type: typ}
+}
+
+// newAction creates a running action. The onSuccess function, if not nil, is called
+// when the action succeeds.
+func (s *Server) newAction(command string, onSuccess func(), resources ...schema.ActionResourceReference) schema.Action {
+	s.lastActionID++
+
+	a := &action{
+		Action: schema.Action{
+			ID:        s.lastActionID,
+			Status:    "running",
+			Command:   command,
+			Progress:  0,
+			Started:   time.Now().UTC(),
+			Resources: resources,
+		},
+		onSuccess: onSuccess,
+	}
+	if actionErr, ok := s.failActions[command]; ok {
+		a.fail = &actionErr
+		delete(s.failActions, command)
+	}
+	s.actions[a.ID] = a
+
+	// The initial response always describes a running action.
+	return a.Action
+}
+
+// readAction returns the current state of the action, and makes it progress towards
+// its final status.
+func (s *Server) readAction(a *action) schema.Action {
+	if a.Status == "running" {
+		if a.reads >= s.actionPolls {
+			s.completeAction(a)
+		} else {
+			a.reads++
+		}
+	}
+	return a.Action
+}
+
+func (s *Server) completeAction(a *action) {
+	finished := time.Now().UTC()
+	a.Finished = &finished
+	a.Progress = 100
+
+	if a.fail != nil {
+		a.Status = "error"
+		a.Error = a.fail
+		return
+	}
+
+	a.Status = "success"
+	if a.onSuccess != nil {
+		a.onSuccess()
+	}
+}
+
+// filterActions returns the actions matching the "id" and "status" query parameters
+// of the request, and the given resource filter.
+func (s *Server) filterActions(r *http.Request, match func(a *action) bool) []schema.Action {
+	query := r.URL.Query()
+
+	ids := make([]int64, 0, len(query["id"]))
+	for _, v := range query["id"] {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	result := []schema.Action{}
+	for _, a := range sorted(s.actions) {
+		if len(ids) > 0 && !slices.Contains(ids, a.ID) {
+			continue
+		}
+		if match != nil && !match(a) {
+			continue
+		}
+		current := s.readAction(a)
+		if query.Has("status") && !slices.Contains(query["status"], current.Status) {
+			continue
+		}
+		result = append(result, current)
+	}
+	return result
+}
+
+// forResource returns a filter that matches actions of a given resource type, and
+// the resource ID from the request path if any.
+func (s *Server) forResource(typ string, r *http.Request) func(a *action) bool {
+	var id int64
+	if r != nil {
+		id = s.resourceID(typ, r.PathValue("id"))
+	}
+	return func(a *action) bool {
+		return slices.ContainsFunc(a.Resources, func(o schema.ActionResourceReference) bool {
+			return o.Type == typ && (id == 0 || o.ID == id)
+		})
+	}
+}
+
+// resourceID returns the ID of a resource from its path identifier. Zones may also be
+// identified by their name.
+func (s *Server) resourceID(typ, idOrName string) int64 {
+	if idOrName == "" {
+		return 0
+	}
+	if id, err := strconv.ParseInt(idOrName, 10, 64); err == nil {
+		return id
+	}
+	if typ == "zone" {
+		for _, zone := range s.zones {
+			if zone.Name == idOrName {
+				return zone.ID
+			}
+		}
+		// Do not match any zone
+		return -1
+	}
+	return 0
+}
+
+func (s *Server) registerActions() {
+	s.handle("GET /actions", func(r *http.Request) (int, any, error) {
+		if !r.URL.Query().Has("id") {
+			return 0, nil, errInvalidInput("id", "at least one id is required")
+		}
+		body, err := paginate(r, "actions", s.filterActions(r, nil))
+		return http.StatusOK, body, err
+	})
+	s.handle("GET /actions/{id}", func(r *http.Request) (int, any, error) {
+		a, err := lookup(r, s.actions, "action")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.ActionGetResponse{Action: s.readAction(a)}, nil
+	})
+}
+
+// registerResourceActions registers the action endpoints of a resource, e.g.
+// "/servers/actions" for the "server" resource type, and the given commands on
+// "/servers/{id}/actions/{command}".
+func (s *Server) registerResourceActions(prefix, typ string, commands map[string]handlerFunc) {
+	s.handle("GET "+prefix+"/actions", func(r *http.Request) (int, any, error) {
+		body, err := paginate(r, "actions", s.filterActions(r, s.forResource(typ, r)))
+		return http.StatusOK, body, err
+	})
+	// A single pattern is used for "/actions/{id}" and "/{id}/actions", as the
+	// http.ServeMux considers them as conflicting.
+	s.handle("GET "+prefix+"/{id}/{sub}", func(r *http.Request) (int, any, error) {
+		switch {
+		case r.PathValue("id") == "actions":
+			id, err := strconv.ParseInt(r.PathValue("sub"), 10, 64)
+			if err != nil {
+				return 0, nil, errInvalidInput("id", "must be an integer")
+			}
+			a, ok := s.actions[id]
+			if !ok || !s.forResource(typ, nil)(a) {
+				return 0, nil, errNotFound("action")
+			}
+			return http.StatusOK, schema.ActionGetResponse{Action: s.readAction(a)}, nil
+		case r.PathValue("sub") == "actions":
+			body, err := paginate(r, "actions", s.filterActions(r, s.forResource(typ, r)))
+			return http.StatusOK, body, err
+		default:
+			return 0, nil, errNotFound("resource")
+		}
+	})
+	s.handle("POST "+prefix+"/{id}/actions/{command}", func(r *http.Request) (int, any, error) {
+		fn, ok := commands[r.PathValue("command")]
+		if !ok {
+			return 0, nil, errNotFound("action")
+		}
+		return fn(r)
+	})
+}
@@ -0,0 +1,155 @@
+package fakeutil
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// seedCatalog populates the read-only resources of the API.
+func (s *Server) seedCatalog() {
+	s.locations = []schema.Location{
+		{ID: 1, Name: "fsn1", Description: "Falkenstein DC Park 1", Country: "DE", City: "Falkenstein", Latitude: 50.47612, Longitude: 12.370071, NetworkZone: "eu-central"},
+		{ID: 2, Name: "nbg1", Description: "Nuremberg DC Park 1", Country: "DE", City: "Nuremberg", Latitude: 49.452102, Longitude: 11.076665, NetworkZone: "eu-central"},
+		{ID: 3, Name: "hel1", Description: "Helsinki DC Park 1", Country: "FI", City: "Helsinki", Latitude: 60.169855, Longitude: 24.938379, NetworkZone: "eu-central"},
+		{ID: 4, Name: "ash", Description: "Ashburn, VA", Country: "US", City: "Ashburn, VA", Latitude: 39.045821, Longitude: -77.487073, NetworkZone: "us-east"},
+	}
+
+	serverTypeLocations := func(names ...string) []schema.ServerTypeLocation {
+		result := make([]schema.ServerTypeLocation, 0, len(names))
+		for _, name := range names {
+			location, _ := s.location(name)
+			result = append(result, schema.ServerTypeLocation{ID: location.ID, Name: location.Name, Available: true, Recommended: true})
+		}
+		return result
+	}
+
+	s.serverTypes = []schema.ServerType{
+		{ID: 1, Name: "cx23", Description: "CX23", Category: "cost_optimized", Cores: 2, Memory: 4, Disk: 40, StorageType: "local", CPUType: "shared", Architecture: "x86", Locations: serverTypeLocations("fsn1", "nbg1", "hel1")},
+		{ID: 2, Name: "cpx22", Description: "CPX22", Category: "regular_purpose", Cores: 2, Memory: 4, Disk: 80, StorageType: "local", CPUType: "shared", Architecture: "x86", Locations: serverTypeLocations("fsn1", "nbg1", "hel1", "ash")},
+		{ID: 3, Name: "cax11", Description: "CAX11", Category: "cost_optimized", Cores: 2, Memory: 4, Disk: 40, StorageType: "local", CPUType: "shared", Architecture: "arm", Locations: serverTypeLocations("fsn1", "nbg1", "hel1")},
+		{ID: 4, Name: "ccx13", Description: "CCX13", Category: "general_purpose", Cores: 2, Memory: 8, Disk: 80, StorageType: "local", CPUType: "dedicated", Architecture: "x86", Locations: serverTypeLocations("fsn1", "nbg1", "hel1", "ash")},
+	}
+
+	s.loadBalancerTypes = []schema.LoadBalancerType{
+		{ID: 1, Name: "lb11", Description: "LB11", MaxConnections: 10000, MaxServices: 5, MaxTargets: 25, MaxAssignedCertificates: 10},
+		{ID: 2, Name: "lb21", Description: "LB21", MaxConnections: 20000, MaxServices: 15, MaxTargets: 75, MaxAssignedCertificates: 25},
+	}
+
+	created := time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)
+	image := func(id int64, name, flavor, version, arch string) schema.Image {
+		return schema.Image{
+			ID: id, Name: &name, Description: name, Type: "system", Status: "available",
+			DiskSize: 5, Created: &created, OSFlavor: flavor, OSVersion: &version,
+			Architecture: arch, RapidDeploy: true, Labels: map[string]string{},
+		}
+	}
+	s.images = []schema.Image{
+		image(1, "ubuntu-24.04", "ubuntu", "24.04", "x86"),
+		image(2, "ubuntu-24.04", "ubuntu", "24.04", "arm"),
+		image(3, "debian-12", "debian", "12", "x86"),
+		image(4, "debian-12", "debian", "12", "arm"),
+	}
+}
+
+func (s *Server) location(idOrName string) (schema.Location, bool) {
+	for _, o := range s.locations {
+		if o.Name == idOrName || strconv.FormatInt(o.ID, 10) == idOrName {
+			return o, true
+		}
+	}
+	return schema.Location{}, false
+}
+
+func (s *Server) serverType(o schema.IDOrName) (schema.ServerType, bool) {
+	for _, t := range s.serverTypes {
+		if t.ID == o.ID || (o.Name != "" && t.Name == o.Name) {
+			return t, true
+		}
+	}
+	return schema.ServerType{}, false
+}
+
+func (s *Server) loadBalancerType(o schema.IDOrName) (schema.LoadBalancerType, bool) {
+	for _, t := range s.loadBalancerTypes {
+		if t.ID == o.ID || (o.Name != "" && t.Name == o.Name) {
+			return t, true
+		}
+	}
+	return schema.LoadBalancerType{}, false
+}
+
+// image returns the image with the given ID, or with the given name and
+// architecture.
+func (s *Server) image(o schema.IDOrName, architecture string) (schema.Image, bool) {
+	for _, i := range s.images {
+		if i.ID == o.ID || (o.Name != "" && i.Name != nil && *i.Name == o.Name && i.Architecture == architecture) {
+			return i, true
+		}
+	}
+	return schema.Image{}, false
+}
+
+// registerCatalog registers read-only list and get endpoints for a catalog resource.
+func registerCatalog[T any](s *Server, prefix, key, singular string, items func() []T, id func(T) int64, match func(*http.Request, T) bool) {
+	s.handle("GET "+prefix, func(r *http.Request) (int, any, error) {
+		result := []T{}
+		for _, item := range items() {
+			if match(r, item) {
+				result = append(result, item)
+			}
+		}
+		body, err := paginate(r, key, result)
+		return http.StatusOK, body, err
+	})
+	s.handle("GET "+prefix+"/{id}", func(r *http.Request) (int, any, error) {
+		wanted, err := pathID(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, item := range items() {
+			if id(item) == wanted {
+				return http.StatusOK, map[string]any{singular: item}, nil
+			}
+		}
+		return 0, nil, errNotFound(singular)
+	})
+}
+
+func (s *Server) registerCatalog() {
+	registerCatalog(s, "/locations", "locations", "location",
+		func() []schema.Location { return s.locations },
+		func(o schema.Location) int64 { return o.ID },
+		func(r *http.Request, o schema.Location) bool { return filterCommon(r, o.Name, nil) },
+	)
+	registerCatalog(s, "/server_types", "server_types", "server_type",
+		func() []schema.ServerType { return s.serverTypes },
+		func(o schema.ServerType) int64 { return o.ID },
+		func(r *http.Request, o schema.ServerType) bool { return filterCommon(r, o.Name, nil) },
+	)
+	registerCatalog(s, "/load_balancer_types", "load_balancer_types", "load_balancer_type",
+		func() []schema.LoadBalancerType { return s.loadBalancerTypes },
+		func(o schema.LoadBalancerType) int64 { return o.ID },
+		func(r *http.Request, o schema.LoadBalancerType) bool { return filterCommon(r, o.Name, nil) },
+	)
+	registerCatalog(s, "/images", "images", "image",
+		func() []schema.Image { return s.images },
+		func(o schema.Image) int64 { return o.ID },
+		func(r *http.Request, o schema.Image) bool {
+			query := r.URL.Query()
+			if query.Has("architecture") && query.Get("architecture") != o.Architecture {
+				return false
+			}
+			if query.Has("type") && query.Get("type") != o.Type {
+				return false
+			}
+			name := ""
+			if o.Name != nil {
+				name = *o.Name
+			}
+			return filterCommon(r, name, o.Labels)
+		},
+	)
+}
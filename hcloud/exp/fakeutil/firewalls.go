@@ -0,0 +1,279 @@
+package fakeutil
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func firewallRulesFromRequest(rules []schema.FirewallRuleRequest) ([]schema.FirewallRule, error) {
+	result := make([]schema.FirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Direction != "in" && rule.Direction != "out" {
+			return nil, errInvalidInput("rules", "direction must be in or out")
+		}
+		if rule.Direction == "in" && len(rule.SourceIPs) == 0 {
+			return nil, errInvalidInput("rules", "source_ips are required for inbound rules")
+		}
+		if rule.Direction == "out" && len(rule.DestinationIPs) == 0 {
+			return nil, errInvalidInput("rules", "destination_ips are required for outbound rules")
+		}
+		if (rule.Protocol == "tcp" || rule.Protocol == "udp") && rule.Port == nil {
+			return nil, errInvalidInput("rules", "port is required for tcp and udp rules")
+		}
+		result = append(result, schema.FirewallRule{
+			Direction:      rule.Direction,
+			SourceIPs:      append([]string{}, rule.SourceIPs...),
+			DestinationIPs: append([]string{}, rule.DestinationIPs...),
+			Protocol:       rule.Protocol,
+			Port:           rule.Port,
+			Description:    rule.Description,
+		})
+	}
+	return result, nil
+}
+
+// firewallServers returns the servers targeted by a firewall resource.
+func (s *Server) firewallServers(resource schema.FirewallResource) []*schema.Server {
+	switch resource.Type {
+	case "server":
+		if resource.Server != nil {
+			if server, ok := s.servers[resource.Server.ID]; ok {
+				return []*schema.Server{server}
+			}
+		}
+	case "label_selector":
+		if resource.LabelSelector != nil {
+			result := []*schema.Server{}
+			for _, server := range sorted(s.servers) {
+				if matchLabelSelector(resource.LabelSelector.Selector, server.Labels) {
+					result = append(result, server)
+				}
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+func sameFirewallResource(a, b schema.FirewallResource) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	switch a.Type {
+	case "server":
+		return a.Server != nil && b.Server != nil && a.Server.ID == b.Server.ID
+	case "label_selector":
+		return a.LabelSelector != nil && b.LabelSelector != nil && a.LabelSelector.Selector == b.LabelSelector.Selector
+	}
+	return false
+}
+
+// applyFirewall applies the firewall to the resource, and returns the servers it was
+// applied to.
+func (s *Server) applyFirewall(firewall *schema.Firewall, resource schema.FirewallResource) []*schema.Server {
+	servers := s.firewallServers(resource)
+	if resource.Type == "label_selector" {
+		resource.AppliedToResources = []schema.FirewallResource{}
+		for _, server := range servers {
+			resource.AppliedToResources = append(resource.AppliedToResources, schema.FirewallResource{
+				Type:   "server",
+				Server: &schema.FirewallResourceServer{ID: server.ID},
+			})
+		}
+	}
+	firewall.AppliedTo = append(firewall.AppliedTo, resource)
+
+	for _, server := range servers {
+		if !slices.ContainsFunc(server.PublicNet.Firewalls, func(o schema.ServerFirewall) bool { return o.ID == firewall.ID }) {
+			server.PublicNet.Firewalls = append(server.PublicNet.Firewalls, schema.ServerFirewall{ID: firewall.ID, Status: "applied"})
+		}
+	}
+	return servers
+}
+
+// removeFirewall removes the firewall from the resource, and returns the servers it
+// was removed from.
+func (s *Server) removeFirewall(firewall *schema.Firewall, resource schema.FirewallResource) []*schema.Server {
+	servers := s.firewallServers(resource)
+	firewall.AppliedTo = slices.DeleteFunc(firewall.AppliedTo, func(o schema.FirewallResource) bool {
+		return sameFirewallResource(o, resource)
+	})
+	for _, server := range servers {
+		server.PublicNet.Firewalls = slices.DeleteFunc(server.PublicNet.Firewalls, func(o schema.ServerFirewall) bool {
+			return o.ID == firewall.ID
+		})
+	}
+	return servers
+}
+
+func (s *Server) registerFirewalls() {
+	s.handle("GET /firewalls", func(r *http.Request) (int, any, error) {
+		result := []schema.Firewall{}
+		for _, o := range sorted(s.firewalls) {
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "firewalls", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /firewalls/{id}", func(r *http.Request) (int, any, error) {
+		firewall, err := lookup(r, s.firewalls, "firewall")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.FirewallGetResponse{Firewall: *firewall}, nil
+	})
+
+	s.handle("POST /firewalls", func(r *http.Request) (int, any, error) {
+		var req schema.FirewallCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		if nameInUse(s.firewalls, req.Name, func(o *schema.Firewall) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+		rules, err := firewallRulesFromRequest(req.Rules)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		firewall := &schema.Firewall{
+			ID:        s.nextID(),
+			Name:      req.Name,
+			Labels:    labelsOrEmpty(req.Labels),
+			Created:   time.Now().UTC(),
+			Rules:     rules,
+			AppliedTo: []schema.FirewallResource{},
+		}
+		s.firewalls[firewall.ID] = firewall
+
+		resp := schema.FirewallCreateResponse{Actions: []schema.Action{}}
+		for _, resource := range req.ApplyTo {
+			for _, server := range s.applyFirewall(firewall, resource) {
+				resp.Actions = append(resp.Actions, s.newAction("apply_firewall", nil, ref("firewall", firewall.ID), ref("server", server.ID)))
+			}
+		}
+		resp.Firewall = *firewall
+		return http.StatusCreated, resp, nil
+	})
+
+	s.handle("PUT /firewalls/{id}", func(r *http.Request) (int, any, error) {
+		firewall, err := lookup(r, s.firewalls, "firewall")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.FirewallUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != nil && *req.Name != firewall.Name {
+			if nameInUse(s.firewalls, *req.Name, func(o *schema.Firewall) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			firewall.Name = *req.Name
+		}
+		if req.Labels != nil {
+			firewall.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.FirewallUpdateResponse{Firewall: *firewall}, nil
+	})
+
+	s.handle("DELETE /firewalls/{id}", func(r *http.Request) (int, any, error) {
+		firewall, err := lookup(r, s.firewalls, "firewall")
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(firewall.AppliedTo) > 0 {
+			return 0, nil, errResourceInUse("firewall is still applied to resources")
+		}
+		delete(s.firewalls, firewall.ID)
+		return http.StatusNoContent, nil, nil
+	})
+
+	s.registerResourceActions("/firewalls", "firewall", map[string]handlerFunc{
+		"set_rules": func(r *http.Request) (int, any, error) {
+			firewall, err := lookup(r, s.firewalls, "firewall")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.FirewallActionSetRulesRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			rules, err := firewallRulesFromRequest(req.Rules)
+			if err != nil {
+				return 0, nil, err
+			}
+			firewall.Rules = rules
+
+			resp := schema.FirewallActionSetRulesResponse{
+				Actions: []schema.Action{s.newAction("set_firewall_rules", nil, ref("firewall", firewall.ID))},
+			}
+			for _, resource := range firewall.AppliedTo {
+				for _, server := range s.firewallServers(resource) {
+					resp.Actions = append(resp.Actions, s.newAction("apply_firewall", nil, ref("firewall", firewall.ID), ref("server", server.ID)))
+				}
+			}
+			return http.StatusCreated, resp, nil
+		},
+
+		"apply_to_resources": func(r *http.Request) (int, any, error) {
+			firewall, err := lookup(r, s.firewalls, "firewall")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.FirewallActionApplyToResourcesRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			for _, resource := range req.ApplyTo {
+				if slices.ContainsFunc(firewall.AppliedTo, func(o schema.FirewallResource) bool { return sameFirewallResource(o, resource) }) {
+					return 0, nil, &apiError{status: http.StatusUnprocessableEntity, code: "firewall_already_applied", message: "firewall is already applied to the resource"}
+				}
+				if resource.Type == "server" && len(s.firewallServers(resource)) == 0 {
+					return 0, nil, errInvalidInput("apply_to", "server not found")
+				}
+			}
+
+			resp := schema.FirewallActionApplyToResourcesResponse{Actions: []schema.Action{}}
+			for _, resource := range req.ApplyTo {
+				for _, server := range s.applyFirewall(firewall, resource) {
+					resp.Actions = append(resp.Actions, s.newAction("apply_firewall", nil, ref("firewall", firewall.ID), ref("server", server.ID)))
+				}
+			}
+			return http.StatusCreated, resp, nil
+		},
+
+		"remove_from_resources": func(r *http.Request) (int, any, error) {
+			firewall, err := lookup(r, s.firewalls, "firewall")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.FirewallActionRemoveFromResourcesRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			for _, resource := range req.RemoveFrom {
+				if !slices.ContainsFunc(firewall.AppliedTo, func(o schema.FirewallResource) bool { return sameFirewallResource(o, resource) }) {
+					return 0, nil, &apiError{status: http.StatusUnprocessableEntity, code: "firewall_already_removed", message: "firewall is not applied to the resource"}
+				}
+			}
+
+			resp := schema.FirewallActionRemoveFromResourcesResponse{Actions: []schema.Action{}}
+			for _, resource := range req.RemoveFrom {
+				for _, server := range s.removeFirewall(firewall, resource) {
+					resp.Actions = append(resp.Actions, s.newAction("remove_firewall", nil, ref("firewall", firewall.ID), ref("server", server.ID)))
+				}
+			}
+			return http.StatusCreated, resp, nil
+		},
+	})
+}
@@ -0,0 +1,568 @@
+package fakeutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// convert converts between request schemas that share the same JSON representation.
+func convert[T any](from any) (T, error) {
+	var to T
+	data, err := json.Marshal(from)
+	if err != nil {
+		return to, err
+	}
+	err = json.Unmarshal(data, &to)
+	return to, err
+}
+
+func loadBalancerServiceFromRequest(req schema.LoadBalancerActionAddServiceRequest) (schema.LoadBalancerService, error) {
+	service := schema.LoadBalancerService{Protocol: req.Protocol}
+
+	switch req.Protocol {
+	case "http":
+		service.ListenPort, service.DestinationPort = 80, 80
+	case "https":
+		service.ListenPort, service.DestinationPort = 443, 80
+	case "tcp":
+		if req.ListenPort == nil || req.DestinationPort == nil {
+			return service, errInvalidInput("listen_port", "listen_port and destination_port are required for tcp services")
+		}
+	default:
+		return service, errInvalidInput("protocol", "must be tcp, http or https")
+	}
+	if req.ListenPort != nil {
+		service.ListenPort = *req.ListenPort
+	}
+	if req.DestinationPort != nil {
+		service.DestinationPort = *req.DestinationPort
+	}
+	if req.Proxyprotocol != nil {
+		service.Proxyprotocol = *req.Proxyprotocol
+	}
+
+	if service.Protocol != "tcp" {
+		service.HTTP = &schema.LoadBalancerServiceHTTP{
+			CookieName:     "HCLBSTICKY",
+			CookieLifetime: 300,
+			Certificates:   []int64{},
+			TimeoutIdle:    60,
+		}
+	}
+	service.HealthCheck = &schema.LoadBalancerServiceHealthCheck{
+		Protocol: "tcp",
+		Port:     service.DestinationPort,
+		Interval: 15,
+		Timeout:  10,
+		Retries:  3,
+	}
+
+	update, err := convert[schema.LoadBalancerActionUpdateServiceRequest](req)
+	if err != nil {
+		return service, err
+	}
+	update.Protocol = nil
+	applyLoadBalancerServiceUpdate(&service, update)
+
+	return service, nil
+}
+
+func applyLoadBalancerServiceUpdate(service *schema.LoadBalancerService, req schema.LoadBalancerActionUpdateServiceRequest) {
+	if req.Protocol != nil {
+		service.Protocol = *req.Protocol
+	}
+	if req.DestinationPort != nil {
+		service.DestinationPort = *req.DestinationPort
+	}
+	if req.Proxyprotocol != nil {
+		service.Proxyprotocol = *req.Proxyprotocol
+	}
+	if o := req.HTTP; o != nil {
+		if service.HTTP == nil {
+			service.HTTP = &schema.LoadBalancerServiceHTTP{Certificates: []int64{}}
+		}
+		if o.CookieName != nil {
+			service.HTTP.CookieName = *o.CookieName
+		}
+		if o.CookieLifetime != nil {
+			service.HTTP.CookieLifetime = *o.CookieLifetime
+		}
+		if o.Certificates != nil {
+			service.HTTP.Certificates = *o.Certificates
+		}
+		if o.RedirectHTTP != nil {
+			service.HTTP.RedirectHTTP = *o.RedirectHTTP
+		}
+		if o.StickySessions != nil {
+			service.HTTP.StickySessions = *o.StickySessions
+		}
+		if o.TimeoutIdle != nil {
+			service.HTTP.TimeoutIdle = *o.TimeoutIdle
+		}
+	}
+	if o := req.HealthCheck; o != nil {
+		if service.HealthCheck == nil {
+			service.HealthCheck = &schema.LoadBalancerServiceHealthCheck{}
+		}
+		if o.Protocol != nil {
+			service.HealthCheck.Protocol = *o.Protocol
+		}
+		if o.Port != nil {
+			service.HealthCheck.Port = *o.Port
+		}
+		if o.Interval != nil {
+			service.HealthCheck.Interval = *o.Interval
+		}
+		if o.Timeout != nil {
+			service.HealthCheck.Timeout = *o.Timeout
+		}
+		if o.Retries != nil {
+			service.HealthCheck.Retries = *o.Retries
+		}
+		if h := o.HTTP; h != nil {
+			if service.HealthCheck.HTTP == nil {
+				service.HealthCheck.HTTP = &schema.LoadBalancerServiceHealthCheckHTTP{Path: "/", StatusCodes: []string{"2??", "3??"}}
+			}
+			if h.Domain != nil {
+				service.HealthCheck.HTTP.Domain = *h.Domain
+			}
+			if h.Path != nil {
+				service.HealthCheck.HTTP.Path = *h.Path
+			}
+			if h.Response != nil {
+				service.HealthCheck.HTTP.Response = *h.Response
+			}
+			if h.StatusCodes != nil {
+				service.HealthCheck.HTTP.StatusCodes = *h.StatusCodes
+			}
+			if h.TLS != nil {
+				service.HealthCheck.HTTP.TLS = *h.TLS
+			}
+		}
+	}
+}
+
+func sameLoadBalancerTarget(a schema.LoadBalancerTarget, b schema.LoadBalancerActionRemoveTargetRequest) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	switch a.Type {
+	case "server":
+		return a.Server != nil && b.Server != nil && a.Server.ID == b.Server.ID
+	case "label_selector":
+		return a.LabelSelector != nil && b.LabelSelector != nil && a.LabelSelector.Selector == b.LabelSelector.Selector
+	case "ip":
+		return a.IP != nil && b.IP != nil && a.IP.IP == b.IP.IP
+	}
+	return false
+}
+
+// loadBalancerTargetFromRequest returns a new target, with the health status of each
+// service of the load balancer.
+func (s *Server) loadBalancerTargetFromRequest(loadBalancer *schema.LoadBalancer, req schema.LoadBalancerActionAddTargetRequest) (schema.LoadBalancerTarget, error) {
+	healthStatus := func() []schema.LoadBalancerTargetHealthStatus {
+		result := make([]schema.LoadBalancerTargetHealthStatus, 0, len(loadBalancer.Services))
+		for _, service := range loadBalancer.Services {
+			result = append(result, schema.LoadBalancerTargetHealthStatus{ListenPort: service.ListenPort, Status: "unknown"})
+		}
+		return result
+	}
+
+	target := schema.LoadBalancerTarget{Type: req.Type, UsePrivateIP: req.UsePrivateIP != nil && *req.UsePrivateIP}
+	switch req.Type {
+	case "server":
+		if req.Server == nil {
+			return target, errInvalidInput("server", "is required")
+		}
+		if _, ok := s.servers[req.Server.ID]; !ok {
+			return target, errInvalidInput("server", "server not found")
+		}
+		target.Server = &schema.LoadBalancerTargetServer{ID: req.Server.ID}
+		target.HealthStatus = healthStatus()
+	case "label_selector":
+		if req.LabelSelector == nil {
+			return target, errInvalidInput("label_selector", "is required")
+		}
+		target.LabelSelector = &schema.LoadBalancerTargetLabelSelector{Selector: req.LabelSelector.Selector}
+		target.Targets = []schema.LoadBalancerTarget{}
+		for _, server := range sorted(s.servers) {
+			if matchLabelSelector(req.LabelSelector.Selector, server.Labels) {
+				target.Targets = append(target.Targets, schema.LoadBalancerTarget{
+					Type:         "server",
+					Server:       &schema.LoadBalancerTargetServer{ID: server.ID},
+					UsePrivateIP: target.UsePrivateIP,
+					HealthStatus: healthStatus(),
+				})
+			}
+		}
+	case "ip":
+		if req.IP == nil {
+			return target, errInvalidInput("ip", "is required")
+		}
+		target.IP = &schema.LoadBalancerTargetIP{IP: req.IP.IP}
+		target.HealthStatus = healthStatus()
+	default:
+		return target, errInvalidInput("type", "must be server, label_selector or ip")
+	}
+
+	for _, o := range loadBalancer.Targets {
+		if sameLoadBalancerTarget(o, schema.LoadBalancerActionRemoveTargetRequest{
+			Type:          target.Type,
+			Server:        (*schema.LoadBalancerActionRemoveTargetRequestServer)(target.Server),
+			LabelSelector: (*schema.LoadBalancerActionRemoveTargetRequestLabelSelector)(target.LabelSelector),
+			IP:            (*schema.LoadBalancerActionRemoveTargetRequestIP)(target.IP),
+		}) {
+			return target, &apiError{status: http.StatusConflict, code: "target_already_defined", message: "target is already defined"}
+		}
+	}
+	return target, nil
+}
+
+func (s *Server) attachLoadBalancerToNetwork(loadBalancer *schema.LoadBalancer, network *schema.Network, ip string) {
+	network.LoadBalancers = append(network.LoadBalancers, loadBalancer.ID)
+	loadBalancer.PrivateNet = append(loadBalancer.PrivateNet, schema.LoadBalancerPrivateNet{Network: network.ID, IP: ip})
+}
+
+func (s *Server) detachLoadBalancerFromNetwork(loadBalancer *schema.LoadBalancer, network *schema.Network) {
+	network.LoadBalancers = slices.DeleteFunc(network.LoadBalancers, func(id int64) bool { return id == loadBalancer.ID })
+	loadBalancer.PrivateNet = slices.DeleteFunc(loadBalancer.PrivateNet, func(o schema.LoadBalancerPrivateNet) bool { return o.Network == network.ID })
+}
+
+func (s *Server) registerLoadBalancers() {
+	s.handle("GET /load_balancers", func(r *http.Request) (int, any, error) {
+		result := []schema.LoadBalancer{}
+		for _, o := range sorted(s.loadBalancers) {
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "load_balancers", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /load_balancers/{id}", func(r *http.Request) (int, any, error) {
+		loadBalancer, err := lookup(r, s.loadBalancers, "load_balancer")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.LoadBalancerGetResponse{LoadBalancer: *loadBalancer}, nil
+	})
+
+	s.handle("POST /load_balancers", s.createLoadBalancer)
+
+	s.handle("PUT /load_balancers/{id}", func(r *http.Request) (int, any, error) {
+		loadBalancer, err := lookup(r, s.loadBalancers, "load_balancer")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.LoadBalancerUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != nil && *req.Name != loadBalancer.Name {
+			if nameInUse(s.loadBalancers, *req.Name, func(o *schema.LoadBalancer) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			loadBalancer.Name = *req.Name
+		}
+		if req.Labels != nil {
+			loadBalancer.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.LoadBalancerUpdateResponse{LoadBalancer: *loadBalancer}, nil
+	})
+
+	s.handle("DELETE /load_balancers/{id}", func(r *http.Request) (int, any, error) {
+		loadBalancer, err := lookup(r, s.loadBalancers, "load_balancer")
+		if err != nil {
+			return 0, nil, err
+		}
+		if loadBalancer.Protection.Delete {
+			return 0, nil, errProtected("load_balancer")
+		}
+		for _, o := range slices.Clone(loadBalancer.PrivateNet) {
+			if network, ok := s.networks[o.Network]; ok {
+				s.detachLoadBalancerFromNetwork(loadBalancer, network)
+			}
+		}
+		delete(s.loadBalancers, loadBalancer.ID)
+		return http.StatusNoContent, nil, nil
+	})
+
+	// loadBalancerAction wraps a load balancer action handler, and returns the action
+	// created with the given command.
+	loadBalancerAction := func(command string, fn func(r *http.Request, loadBalancer *schema.LoadBalancer) error) handlerFunc {
+		return func(r *http.Request) (int, any, error) {
+			loadBalancer, err := lookup(r, s.loadBalancers, "load_balancer")
+			if err != nil {
+				return 0, nil, err
+			}
+			if err := fn(r, loadBalancer); err != nil {
+				return 0, nil, err
+			}
+			action := s.newAction(command, nil, ref("load_balancer", loadBalancer.ID))
+			return http.StatusCreated, schema.LoadBalancerActionAddServiceResponse{Action: action}, nil
+		}
+	}
+
+	s.registerResourceActions("/load_balancers", "load_balancer", map[string]handlerFunc{
+		"add_service": loadBalancerAction("add_service", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionAddServiceRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			service, err := loadBalancerServiceFromRequest(req)
+			if err != nil {
+				return err
+			}
+			if slices.ContainsFunc(loadBalancer.Services, func(o schema.LoadBalancerService) bool { return o.ListenPort == service.ListenPort }) {
+				return &apiError{status: http.StatusConflict, code: "source_port_already_used", message: "listen port is already used"}
+			}
+			loadBalancer.Services = append(loadBalancer.Services, service)
+			return nil
+		}),
+
+		"update_service": loadBalancerAction("update_service", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionUpdateServiceRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			i := slices.IndexFunc(loadBalancer.Services, func(o schema.LoadBalancerService) bool { return o.ListenPort == req.ListenPort })
+			if i < 0 {
+				return errNotFound("service")
+			}
+			applyLoadBalancerServiceUpdate(&loadBalancer.Services[i], req)
+			return nil
+		}),
+
+		"delete_service": loadBalancerAction("delete_service", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerDeleteServiceRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			i := slices.IndexFunc(loadBalancer.Services, func(o schema.LoadBalancerService) bool { return o.ListenPort == req.ListenPort })
+			if i < 0 {
+				return errNotFound("service")
+			}
+			loadBalancer.Services = slices.Delete(loadBalancer.Services, i, i+1)
+			return nil
+		}),
+
+		"add_target": loadBalancerAction("add_target", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionAddTargetRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			target, err := s.loadBalancerTargetFromRequest(loadBalancer, req)
+			if err != nil {
+				return err
+			}
+			loadBalancer.Targets = append(loadBalancer.Targets, target)
+			return nil
+		}),
+
+		"remove_target": loadBalancerAction("remove_target", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionRemoveTargetRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			i := slices.IndexFunc(loadBalancer.Targets, func(o schema.LoadBalancerTarget) bool { return sameLoadBalancerTarget(o, req) })
+			if i < 0 {
+				return errNotFound("target")
+			}
+			loadBalancer.Targets = slices.Delete(loadBalancer.Targets, i, i+1)
+			return nil
+		}),
+
+		"change_algorithm": loadBalancerAction("change_algorithm", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionChangeAlgorithmRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.Type != "round_robin" && req.Type != "least_connections" {
+				return errInvalidInput("type", "must be round_robin or least_connections")
+			}
+			loadBalancer.Algorithm.Type = req.Type
+			return nil
+		}),
+
+		"change_type": loadBalancerAction("change_load_balancer_type", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionChangeTypeRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			loadBalancerType, ok := s.loadBalancerType(req.LoadBalancerType)
+			if !ok {
+				return errInvalidInput("load_balancer_type", "load balancer type not found")
+			}
+			loadBalancer.LoadBalancerType = loadBalancerType
+			return nil
+		}),
+
+		"attach_to_network": loadBalancerAction("attach_to_network", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionAttachToNetworkRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			network, ok := s.networks[req.Network]
+			if !ok {
+				return errNotFound("network")
+			}
+			if slices.Contains(network.LoadBalancers, loadBalancer.ID) {
+				return &apiError{status: http.StatusUnprocessableEntity, code: "load_balancer_already_attached", message: "load balancer is already attached to the network"}
+			}
+			ip := s.allocatePrivateIP(network)
+			if req.IP != nil {
+				ip = *req.IP
+			}
+			s.attachLoadBalancerToNetwork(loadBalancer, network, ip)
+			return nil
+		}),
+
+		"detach_from_network": loadBalancerAction("detach_from_network", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionDetachFromNetworkRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			network, ok := s.networks[req.Network]
+			if !ok {
+				return errNotFound("network")
+			}
+			s.detachLoadBalancerFromNetwork(loadBalancer, network)
+			return nil
+		}),
+
+		"enable_public_interface": loadBalancerAction("enable_public_interface", func(_ *http.Request, loadBalancer *schema.LoadBalancer) error {
+			loadBalancer.PublicNet.Enabled = true
+			return nil
+		}),
+
+		"disable_public_interface": loadBalancerAction("disable_public_interface", func(_ *http.Request, loadBalancer *schema.LoadBalancer) error {
+			if len(loadBalancer.PrivateNet) == 0 {
+				return &apiError{status: http.StatusUnprocessableEntity, code: "load_balancer_not_attached_to_network", message: "load balancer is not attached to a network"}
+			}
+			loadBalancer.PublicNet.Enabled = false
+			return nil
+		}),
+
+		"change_dns_ptr": loadBalancerAction("change_dns_ptr", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.ServerActionChangeDNSPtrRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			dnsPtr := ""
+			if req.DNSPtr != nil {
+				dnsPtr = *req.DNSPtr
+			}
+			switch req.IP {
+			case loadBalancer.PublicNet.IPv4.IP:
+				loadBalancer.PublicNet.IPv4.DNSPtr = dnsPtr
+			case loadBalancer.PublicNet.IPv6.IP:
+				loadBalancer.PublicNet.IPv6.DNSPtr = dnsPtr
+			default:
+				return errInvalidInput("ip", "ip does not belong to the load balancer")
+			}
+			return nil
+		}),
+
+		"change_protection": loadBalancerAction("change_protection", func(r *http.Request, loadBalancer *schema.LoadBalancer) error {
+			var req schema.LoadBalancerActionChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.Delete != nil {
+				loadBalancer.Protection.Delete = *req.Delete
+			}
+			return nil
+		}),
+	})
+}
+
+func (s *Server) createLoadBalancer(r *http.Request) (int, any, error) {
+	var req schema.LoadBalancerCreateRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.Name == "" {
+		return 0, nil, errInvalidInput("name", "is required")
+	}
+	if nameInUse(s.loadBalancers, req.Name, func(o *schema.LoadBalancer) string { return o.Name }) {
+		return 0, nil, errUniqueness("name")
+	}
+	loadBalancerType, ok := s.loadBalancerType(req.LoadBalancerType)
+	if !ok {
+		return 0, nil, errInvalidInput("load_balancer_type", "load balancer type not found")
+	}
+	if req.Location == nil {
+		return 0, nil, errInvalidInput("location", "is required")
+	}
+	location, ok := s.location(*req.Location)
+	if !ok {
+		return 0, nil, errInvalidInput("location", "location not found")
+	}
+	var network *schema.Network
+	if req.Network != nil {
+		network, ok = s.networks[*req.Network]
+		if !ok {
+			return 0, nil, errInvalidInput("network", "network not found")
+		}
+	}
+
+	id := s.nextID()
+	loadBalancer := &schema.LoadBalancer{
+		ID:   id,
+		Name: req.Name,
+		PublicNet: schema.LoadBalancerPublicNet{
+			Enabled: req.PublicInterface == nil || *req.PublicInterface,
+			IPv4:    schema.LoadBalancerPublicNetIPv4{IP: publicIP("ipv4", id)},
+			IPv6:    schema.LoadBalancerPublicNetIPv6{IP: fmt.Sprintf("2001:db8:%x::1", id)},
+		},
+		PrivateNet:       []schema.LoadBalancerPrivateNet{},
+		Location:         location,
+		LoadBalancerType: loadBalancerType,
+		Labels:           labelsOrEmpty(req.Labels),
+		Created:          time.Now().UTC(),
+		Services:         []schema.LoadBalancerService{},
+		Targets:          []schema.LoadBalancerTarget{},
+		Algorithm:        schema.LoadBalancerAlgorithm{Type: "round_robin"},
+		IncludedTraffic:  21990232555520,
+	}
+	if req.Algorithm != nil {
+		loadBalancer.Algorithm.Type = req.Algorithm.Type
+	}
+
+	for _, o := range req.Services {
+		serviceReq, err := convert[schema.LoadBalancerActionAddServiceRequest](o)
+		if err != nil {
+			return 0, nil, err
+		}
+		service, err := loadBalancerServiceFromRequest(serviceReq)
+		if err != nil {
+			return 0, nil, err
+		}
+		loadBalancer.Services = append(loadBalancer.Services, service)
+	}
+	for _, o := range req.Targets {
+		targetReq, err := convert[schema.LoadBalancerActionAddTargetRequest](o)
+		if err != nil {
+			return 0, nil, err
+		}
+		target, err := s.loadBalancerTargetFromRequest(loadBalancer, targetReq)
+		if err != nil {
+			return 0, nil, err
+		}
+		loadBalancer.Targets = append(loadBalancer.Targets, target)
+	}
+
+	s.loadBalancers[loadBalancer.ID] = loadBalancer
+	if network != nil {
+		s.attachLoadBalancerToNetwork(loadBalancer, network, s.allocatePrivateIP(network))
+	}
+
+	action := s.newAction("create_load_balancer", nil, ref("load_balancer", loadBalancer.ID))
+	return http.StatusCreated, schema.LoadBalancerCreateResponse{LoadBalancer: *loadBalancer, Action: action}, nil
+}
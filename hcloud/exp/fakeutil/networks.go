@@ -0,0 +1,278 @@
+package fakeutil
+
+import (
+	"net/http"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// allocatePrivateIP returns the first unused IP of the network subnets, skipping the
+// gateway address.
+func (s *Server) allocatePrivateIP(network *schema.Network) string {
+	used := map[string]bool{}
+	for _, id := range network.Servers {
+		if server, ok := s.servers[id]; ok {
+			for _, o := range server.PrivateNet {
+				used[o.IP] = true
+			}
+		}
+	}
+	for _, id := range network.LoadBalancers {
+		if loadBalancer, ok := s.loadBalancers[id]; ok {
+			for _, o := range loadBalancer.PrivateNet {
+				used[o.IP] = true
+			}
+		}
+	}
+
+	ranges := make([]string, 0, len(network.Subnets)+1)
+	for _, subnet := range network.Subnets {
+		ranges = append(ranges, subnet.IPRange)
+	}
+	ranges = append(ranges, network.IPRange)
+
+	for _, ipRange := range ranges {
+		prefix, err := netip.ParsePrefix(ipRange)
+		if err != nil {
+			continue
+		}
+		// Skip the network and the gateway addresses
+		for addr := prefix.Masked().Addr().Next().Next(); prefix.Contains(addr); addr = addr.Next() {
+			if !used[addr.String()] {
+				return addr.String()
+			}
+		}
+	}
+	return ""
+}
+
+// gateway returns the gateway address of the network IP range.
+func gateway(ipRange string) string {
+	prefix, err := netip.ParsePrefix(ipRange)
+	if err != nil {
+		return ""
+	}
+	return prefix.Masked().Addr().Next().String()
+}
+
+func (s *Server) attachServerToNetwork(server *schema.Server, network *schema.Network, ip string) {
+	network.Servers = append(network.Servers, server.ID)
+	server.PrivateNet = append(server.PrivateNet, schema.ServerPrivateNet{
+		Network:    network.ID,
+		IP:         ip,
+		AliasIPs:   []string{},
+		MACAddress: "86:00:00:00:00:00",
+	})
+}
+
+func (s *Server) detachServerFromNetwork(server *schema.Server, network *schema.Network) {
+	network.Servers = slices.DeleteFunc(network.Servers, func(id int64) bool { return id == server.ID })
+	server.PrivateNet = slices.DeleteFunc(server.PrivateNet, func(o schema.ServerPrivateNet) bool { return o.Network == network.ID })
+}
+
+func (s *Server) registerNetworks() {
+	s.handle("GET /networks", func(r *http.Request) (int, any, error) {
+		result := []schema.Network{}
+		for _, o := range sorted(s.networks) {
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "networks", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /networks/{id}", func(r *http.Request) (int, any, error) {
+		network, err := lookup(r, s.networks, "network")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.NetworkGetResponse{Network: *network}, nil
+	})
+
+	s.handle("POST /networks", func(r *http.Request) (int, any, error) {
+		var req schema.NetworkCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		if _, err := netip.ParsePrefix(req.IPRange); err != nil {
+			return 0, nil, errInvalidInput("ip_range", "must be a valid CIDR")
+		}
+		if nameInUse(s.networks, req.Name, func(o *schema.Network) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+
+		network := &schema.Network{
+			ID:                    s.nextID(),
+			Name:                  req.Name,
+			Created:               time.Now().UTC(),
+			IPRange:               req.IPRange,
+			Subnets:               []schema.NetworkSubnet{},
+			Routes:                []schema.NetworkRoute{},
+			Servers:               []int64{},
+			LoadBalancers:         []int64{},
+			Labels:                labelsOrEmpty(req.Labels),
+			ExposeRoutesToVSwitch: req.ExposeRoutesToVSwitch,
+		}
+		for _, subnet := range req.Subnets {
+			subnet.Gateway = gateway(req.IPRange)
+			network.Subnets = append(network.Subnets, subnet)
+		}
+		network.Routes = append(network.Routes, req.Routes...)
+		s.networks[network.ID] = network
+
+		return http.StatusCreated, schema.NetworkCreateResponse{Network: *network}, nil
+	})
+
+	s.handle("PUT /networks/{id}", func(r *http.Request) (int, any, error) {
+		network, err := lookup(r, s.networks, "network")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.NetworkUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != "" && req.Name != network.Name {
+			if nameInUse(s.networks, req.Name, func(o *schema.Network) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			network.Name = req.Name
+		}
+		if req.Labels != nil {
+			network.Labels = labelsOrEmpty(req.Labels)
+		}
+		if req.ExposeRoutesToVSwitch != nil {
+			network.ExposeRoutesToVSwitch = *req.ExposeRoutesToVSwitch
+		}
+		return http.StatusOK, schema.NetworkUpdateResponse{Network: *network}, nil
+	})
+
+	s.handle("DELETE /networks/{id}", func(r *http.Request) (int, any, error) {
+		network, err := lookup(r, s.networks, "network")
+		if err != nil {
+			return 0, nil, err
+		}
+		if network.Protection.Delete {
+			return 0, nil, errProtected("network")
+		}
+		for _, id := range slices.Clone(network.Servers) {
+			if server, ok := s.servers[id]; ok {
+				s.detachServerFromNetwork(server, network)
+			}
+		}
+		for _, id := range slices.Clone(network.LoadBalancers) {
+			if loadBalancer, ok := s.loadBalancers[id]; ok {
+				s.detachLoadBalancerFromNetwork(loadBalancer, network)
+			}
+		}
+		delete(s.networks, network.ID)
+		return http.StatusNoContent, nil, nil
+	})
+
+	s.registerResourceActions("/networks", "network", map[string]handlerFunc{
+		"add_subnet": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionAddSubnetRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if slices.ContainsFunc(network.Subnets, func(o schema.NetworkSubnet) bool { return o.IPRange == req.IPRange }) {
+				return 0, nil, errUniqueness("ip_range")
+			}
+			network.Subnets = append(network.Subnets, schema.NetworkSubnet{
+				Type:        req.Type,
+				IPRange:     req.IPRange,
+				NetworkZone: req.NetworkZone,
+				Gateway:     gateway(network.IPRange),
+				VSwitchID:   req.VSwitchID,
+			})
+			action := s.newAction("add_subnet", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionAddSubnetResponse{Action: action}, nil
+		},
+
+		"delete_subnet": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionDeleteSubnetRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			network.Subnets = slices.DeleteFunc(network.Subnets, func(o schema.NetworkSubnet) bool { return o.IPRange == req.IPRange })
+			action := s.newAction("delete_subnet", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionDeleteSubnetResponse{Action: action}, nil
+		},
+
+		"add_route": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionAddRouteRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			network.Routes = append(network.Routes, schema.NetworkRoute(req))
+			action := s.newAction("add_route", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionAddRouteResponse{Action: action}, nil
+		},
+
+		"delete_route": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionDeleteRouteRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			network.Routes = slices.DeleteFunc(network.Routes, func(o schema.NetworkRoute) bool { return o == schema.NetworkRoute(req) })
+			action := s.newAction("delete_route", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionDeleteRouteResponse{Action: action}, nil
+		},
+
+		"change_ip_range": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionChangeIPRangeRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if _, err := netip.ParsePrefix(req.IPRange); err != nil {
+				return 0, nil, errInvalidInput("ip_range", "must be a valid CIDR")
+			}
+			network.IPRange = req.IPRange
+			action := s.newAction("change_ip_range", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionChangeIPRangeResponse{Action: action}, nil
+		},
+
+		"change_protection": func(r *http.Request) (int, any, error) {
+			network, err := lookup(r, s.networks, "network")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.NetworkActionChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if req.Delete != nil {
+				network.Protection.Delete = *req.Delete
+			}
+			action := s.newAction("change_protection", nil, ref("network", network.ID))
+			return http.StatusCreated, schema.NetworkActionChangeProtectionResponse{Action: action}, nil
+		},
+	})
+}
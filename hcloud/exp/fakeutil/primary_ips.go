@@ -0,0 +1,242 @@
+package fakeutil
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// publicIP returns a unique public IP address (or network for IPv6) from the
+// documentation and benchmarking ranges.
+func publicIP(typ string, id int64) string {
+	if typ == "ipv6" {
+		return fmt.Sprintf("2001:db8:%x::/64", id)
+	}
+	return fmt.Sprintf("198.18.%d.%d", (id>>8)&0xff, id&0xff)
+}
+
+// newPrimaryIP stores a new unassigned primary IP. When name is empty, a name is
+// generated from the primary IP ID.
+func (s *Server) newPrimaryIP(name, typ string, location schema.Location, autoDelete bool) *schema.PrimaryIP {
+	id := s.nextID()
+	if name == "" {
+		name = fmt.Sprintf("primary_ip-%d", id)
+	}
+	primaryIP := &schema.PrimaryIP{
+		ID:           id,
+		Name:         name,
+		Type:         typ,
+		IP:           publicIP(typ, id),
+		Labels:       map[string]string{},
+		AssigneeType: "server",
+		AutoDelete:   autoDelete,
+		Created:      time.Now().UTC(),
+		Location:     location,
+		DNSPtr:       []schema.PrimaryIPDNSPTR{},
+	}
+	s.primaryIPs[id] = primaryIP
+	return primaryIP
+}
+
+// assignPrimaryIP assigns the primary IP to the server public network.
+func (s *Server) assignPrimaryIP(primaryIP *schema.PrimaryIP, server *schema.Server) {
+	primaryIP.AssigneeID = &server.ID
+	if primaryIP.Type == "ipv6" {
+		server.PublicNet.IPv6 = schema.ServerPublicNetIPv6{ID: primaryIP.ID, IP: primaryIP.IP, DNSPtr: []schema.ServerPublicNetIPv6DNSPtr{}}
+	} else {
+		server.PublicNet.IPv4 = schema.ServerPublicNetIPv4{ID: primaryIP.ID, IP: primaryIP.IP}
+	}
+}
+
+// unassignPrimaryIP removes the primary IP from its assignee public network.
+func (s *Server) unassignPrimaryIP(primaryIP *schema.PrimaryIP) {
+	if primaryIP.AssigneeID == nil {
+		return
+	}
+	if server, ok := s.servers[*primaryIP.AssigneeID]; ok {
+		if primaryIP.Type == "ipv6" {
+			server.PublicNet.IPv6 = schema.ServerPublicNetIPv6{}
+		} else {
+			server.PublicNet.IPv4 = schema.ServerPublicNetIPv4{}
+		}
+	}
+	primaryIP.AssigneeID = nil
+}
+
+func (s *Server) registerPrimaryIPs() {
+	s.handle("GET /primary_ips", func(r *http.Request) (int, any, error) {
+		query := r.URL.Query()
+		result := []schema.PrimaryIP{}
+		for _, o := range sorted(s.primaryIPs) {
+			if query.Has("ip") && query.Get("ip") != o.IP {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "primary_ips", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /primary_ips/{id}", func(r *http.Request) (int, any, error) {
+		primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.PrimaryIPGetResponse{PrimaryIP: *primaryIP}, nil
+	})
+
+	s.handle("POST /primary_ips", func(r *http.Request) (int, any, error) {
+		var req schema.PrimaryIPCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		if req.Type != "ipv4" && req.Type != "ipv6" {
+			return 0, nil, errInvalidInput("type", "must be ipv4 or ipv6")
+		}
+		if nameInUse(s.primaryIPs, req.Name, func(o *schema.PrimaryIP) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+
+		var server *schema.Server
+		var location schema.Location
+		switch {
+		case req.AssigneeID != nil:
+			var ok bool
+			server, ok = s.servers[*req.AssigneeID]
+			if !ok {
+				return 0, nil, errInvalidInput("assignee_id", "server not found")
+			}
+			location = server.Location
+		case req.Location != "":
+			var ok bool
+			location, ok = s.location(req.Location)
+			if !ok {
+				return 0, nil, errInvalidInput("location", "location not found")
+			}
+		default:
+			return 0, nil, errInvalidInput("location", "location or assignee_id is required")
+		}
+
+		primaryIP := s.newPrimaryIP(req.Name, req.Type, location, req.AutoDelete != nil && *req.AutoDelete)
+		primaryIP.Labels = labelsOrEmpty(req.Labels)
+
+		resp := schema.PrimaryIPCreateResponse{PrimaryIP: *primaryIP}
+		if server != nil {
+			s.assignPrimaryIP(primaryIP, server)
+			resp.PrimaryIP = *primaryIP
+			action := s.newAction("create_primary_ip", nil, ref("primary_ip", primaryIP.ID), ref("server", server.ID))
+			resp.Action = &action
+		}
+		return http.StatusCreated, resp, nil
+	})
+
+	s.handle("PUT /primary_ips/{id}", func(r *http.Request) (int, any, error) {
+		primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.PrimaryIPUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != "" && req.Name != primaryIP.Name {
+			if nameInUse(s.primaryIPs, req.Name, func(o *schema.PrimaryIP) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			primaryIP.Name = req.Name
+		}
+		if req.Labels != nil {
+			primaryIP.Labels = labelsOrEmpty(req.Labels)
+		}
+		if req.AutoDelete != nil {
+			primaryIP.AutoDelete = *req.AutoDelete
+		}
+		return http.StatusOK, schema.PrimaryIPUpdateResponse{PrimaryIP: *primaryIP}, nil
+	})
+
+	s.handle("DELETE /primary_ips/{id}", func(r *http.Request) (int, any, error) {
+		primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+		if err != nil {
+			return 0, nil, err
+		}
+		if primaryIP.Protection.Delete {
+			return 0, nil, errProtected("primary_ip")
+		}
+		if primaryIP.AssigneeID != nil {
+			return 0, nil, errResourceInUse("primary ip is assigned")
+		}
+		delete(s.primaryIPs, primaryIP.ID)
+		return http.StatusNoContent, nil, nil
+	})
+
+	s.registerResourceActions("/primary_ips", "primary_ip", map[string]handlerFunc{
+		"assign": func(r *http.Request) (int, any, error) {
+			primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.PrimaryIPActionAssignRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			server, ok := s.servers[req.AssigneeID]
+			if !ok {
+				return 0, nil, errInvalidInput("assignee_id", "server not found")
+			}
+			if primaryIP.AssigneeID != nil {
+				return 0, nil, errResourceInUse("primary ip is already assigned")
+			}
+			s.assignPrimaryIP(primaryIP, server)
+			action := s.newAction("assign_primary_ip", nil, ref("primary_ip", primaryIP.ID), ref("server", server.ID))
+			return http.StatusCreated, schema.PrimaryIPActionAssignResponse{Action: action}, nil
+		},
+
+		"unassign": func(r *http.Request) (int, any, error) {
+			primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+			if err != nil {
+				return 0, nil, err
+			}
+			s.unassignPrimaryIP(primaryIP)
+			action := s.newAction("unassign_primary_ip", nil, ref("primary_ip", primaryIP.ID))
+			return http.StatusCreated, schema.PrimaryIPActionUnassignResponse{Action: action}, nil
+		},
+
+		"change_dns_ptr": func(r *http.Request) (int, any, error) {
+			primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.PrimaryIPActionChangeDNSPtrRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			primaryIP.DNSPtr = []schema.PrimaryIPDNSPTR{}
+			if req.DNSPtr != nil {
+				primaryIP.DNSPtr = append(primaryIP.DNSPtr, schema.PrimaryIPDNSPTR{IP: req.IP, DNSPtr: *req.DNSPtr})
+			}
+			action := s.newAction("change_dns_ptr", nil, ref("primary_ip", primaryIP.ID))
+			return http.StatusCreated, schema.PrimaryIPActionChangeDNSPtrResponse{Action: action}, nil
+		},
+
+		"change_protection": func(r *http.Request) (int, any, error) {
+			primaryIP, err := lookup(r, s.primaryIPs, "primary_ip")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.PrimaryIPActionChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			primaryIP.Protection.Delete = req.Delete
+			action := s.newAction("change_protection", nil, ref("primary_ip", primaryIP.ID))
+			return http.StatusCreated, schema.PrimaryIPActionChangeProtectionResponse{Action: action}, nil
+		},
+	})
+}
@@ -0,0 +1,377 @@
+package fakeutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/labelutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// Option configures a [Server].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Option func(*Server)
+
+// WithToken configures the [Server] to only accept requests authenticated with the
+// given token. By default, any token is accepted.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithActionPolls configures how many times a running action must be read before it
+// completes. By default, actions complete on their second read, so that callers
+// waiting on actions observe at least one running state.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func WithActionPolls(polls int) Option {
+	return func(s *Server) {
+		s.actionPolls = polls
+	}
+}
+
+// NewServer returns a new fake server that closes itself at the end of the test.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewServer(t *testing.T, opts ...Option) *Server {
+	t.Helper()
+
+	s := New(opts...)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// New returns a new fake server. The caller is responsible to close the server.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func New(opts ...Option) *Server {
+	s := &Server{
+		actionPolls: 1,
+
+		actions:     map[int64]*action{},
+		failActions: map[string]schema.ActionError{},
+
+		servers:       map[int64]*schema.Server{},
+		volumes:       map[int64]*schema.Volume{},
+		networks:      map[int64]*schema.Network{},
+		firewalls:     map[int64]*schema.Firewall{},
+		loadBalancers: map[int64]*schema.LoadBalancer{},
+		primaryIPs:    map[int64]*schema.PrimaryIP{},
+		sshKeys:       map[int64]*schema.SSHKey{},
+		zones:         map[int64]*schema.Zone{},
+		rrsets:        map[int64]map[string]*schema.ZoneRRSet{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.seedCatalog()
+
+	s.mux = http.NewServeMux()
+	s.registerActions()
+	s.registerCatalog()
+	s.registerServers()
+	s.registerVolumes()
+	s.registerNetworks()
+	s.registerFirewalls()
+	s.registerLoadBalancers()
+	s.registerPrimaryIPs()
+	s.registerSSHKeys()
+	s.registerZones()
+
+	s.Server = httptest.NewServer(s.mux)
+
+	return s
+}
+
+// Server embeds a [httptest.Server] that implements a stateful subset of the Hetzner
+// Cloud API, backed by the [schema] types.
+//
+// Resources created through the API are kept in memory, and the actions returned by
+// the API transition from running to success (or error, see [Server.FailNextAction])
+// as they are polled, so [hcloud.ActionClient.WaitFor] works end to end.
+//
+// Supported resources are servers, volumes, networks, firewalls, load balancers,
+// primary IPs, SSH keys, zones and their RRSets, as well as a read-only catalog of
+// locations, server types, load balancer types and images.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Server struct {
+	*httptest.Server
+
+	mux *http.ServeMux
+	mu  sync.Mutex
+
+	token       string
+	actionPolls int
+
+	lastID       int64
+	lastActionID int64
+
+	actions     map[int64]*action
+	failActions map[string]schema.ActionError
+
+	locations         []schema.Location
+	serverTypes       []schema.ServerType
+	loadBalancerTypes []schema.LoadBalancerType
+	images            []schema.Image
+
+	servers       map[int64]*schema.Server
+	volumes       map[int64]*schema.Volume
+	networks      map[int64]*schema.Network
+	firewalls     map[int64]*schema.Firewall
+	loadBalancers map[int64]*schema.LoadBalancer
+	primaryIPs    map[int64]*schema.PrimaryIP
+	sshKeys       map[int64]*schema.SSHKey
+	zones         map[int64]*schema.Zone
+	rrsets        map[int64]map[string]*schema.ZoneRRSet
+}
+
+// FailNextAction makes the next action created with the given command (e.g.
+// "create_server") end with the given error instead of succeeding.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (s *Server) FailNextAction(command string, actionErr schema.ActionError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failActions[command] = actionErr
+}
+
+// nextID returns a new unique resource ID. All resources share the same sequence.
+func (s *Server) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+// handlerFunc handles a request and returns the status code and the body to encode,
+// or an error. The state lock is held while the handler runs and the body is encoded.
+type handlerFunc func(r *http.Request) (int, any, error)
+
+func (s *Server) handle(pattern string, fn handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+			writeError(w, errUnauthorized())
+			return
+		}
+
+		s.mu.Lock()
+		status, body, err := fn(r)
+		if err == nil && body != nil {
+			// The body shares its slices and maps with the state, which other requests
+			// modify in place: encode it before releasing the lock.
+			var data []byte
+			data, err = json.Marshal(body)
+			body = json.RawMessage(data)
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, status, body)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr, ok := err.(*apiError) // nolint: errorlint
+	if !ok {
+		apiErr = &apiError{status: http.StatusInternalServerError, code: "service_error", message: err.Error()}
+	}
+	writeJSON(w, apiErr.status, schema.ErrorResponse{Error: schema.Error{
+		Code:       apiErr.code,
+		Message:    apiErr.message,
+		DetailsRaw: apiErr.details,
+	}})
+}
+
+// apiError is an error returned to the client with the API error schema.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	details json.RawMessage
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.message, e.code)
+}
+
+func errUnauthorized() error {
+	return &apiError{status: http.StatusUnauthorized, code: "unauthorized", message: "unable to authenticate"}
+}
+
+func errNotFound(kind string) error {
+	return &apiError{status: http.StatusNotFound, code: "not_found", message: kind + " not found"}
+}
+
+func errInvalidInput(field, message string) error {
+	details, _ := json.Marshal(map[string]any{
+		"fields": []map[string]any{{"name": field, "messages": []string{message}}},
+	})
+	return &apiError{
+		status:  http.StatusBadRequest,
+		code:    "invalid_input",
+		message: fmt.Sprintf("invalid input in field '%s'", field),
+		details: details,
+	}
+}
+
+func errUniqueness(field string) error {
+	return &apiError{status: http.StatusConflict, code: "uniqueness_error", message: field + " is already used"}
+}
+
+func errProtected(kind string) error {
+	return &apiError{status: http.StatusLocked, code: "protected", message: kind + " is protected"}
+}
+
+func errResourceInUse(message string) error {
+	return &apiError{status: http.StatusConflict, code: "resource_in_use", message: message}
+}
+
+func errUnsupported(message string) error {
+	return &apiError{status: http.StatusUnprocessableEntity, code: "unsupported_error", message: message}
+}
+
+// decode reads the JSON request body into v.
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return &apiError{status: http.StatusBadRequest, code: "json_error", message: err.Error()}
+	}
+	return nil
+}
+
+// pathID parses the "id" path value of the request.
+func pathID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, errInvalidInput("id", "must be an integer")
+	}
+	return id, nil
+}
+
+// lookup returns the item with the "id" path value from the items map.
+func lookup[T any](r *http.Request, items map[int64]*T, kind string) (*T, error) {
+	id, err := pathID(r)
+	if err != nil {
+		return nil, err
+	}
+	item, ok := items[id]
+	if !ok {
+		return nil, errNotFound(kind)
+	}
+	return item, nil
+}
+
+// sorted returns the items of the map, ordered by ID.
+func sorted[T any](items map[int64]*T) []*T {
+	result := make([]*T, 0, len(items))
+	for _, id := range slices.Sorted(maps.Keys(items)) {
+		result = append(result, items[id])
+	}
+	return result
+}
+
+// filterCommon reports whether the resource matches the "name" and "label_selector"
+// query parameters of the request.
+func filterCommon(r *http.Request, name string, labels map[string]string) bool {
+	query := r.URL.Query()
+	if query.Has("name") && query.Get("name") != name {
+		return false
+	}
+	if query.Has("label_selector") && !matchLabelSelector(query.Get("label_selector"), labels) {
+		return false
+	}
+	return true
+}
+
+// matchLabelSelector reports whether the labels match the label selector. An invalid
+// label selector never matches.
+func matchLabelSelector(selector string, labels map[string]string) bool {
+	matches, err := labelutil.Matches(selector, labels)
+	return err == nil && matches
+}
+
+// paginate returns a list response body holding a single page of the items under
+// the given key, along with the pagination meta.
+func paginate[T any](r *http.Request, key string, items []T) (map[string]any, error) {
+	query := r.URL.Query()
+
+	page, perPage := 1, 25
+	if v := query.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, errInvalidInput("page", "must be a positive integer")
+		}
+		page = n
+	}
+	if v := query.Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 50 {
+			return nil, errInvalidInput("per_page", "must be an integer between 1 and 50")
+		}
+		perPage = n
+	}
+
+	lastPage := max(1, (len(items)+perPage-1)/perPage)
+	pagination := schema.MetaPagination{
+		Page:         page,
+		PerPage:      perPage,
+		LastPage:     lastPage,
+		TotalEntries: len(items),
+	}
+	if page > 1 {
+		pagination.PreviousPage = page - 1
+	}
+	if page < lastPage {
+		pagination.NextPage = page + 1
+	}
+
+	start := min(len(items), (page-1)*perPage)
+	end := min(len(items), start+perPage)
+
+	return map[string]any{
+		key:    items[start:end],
+		"meta": schema.Meta{Pagination: &pagination},
+	}, nil
+}
+
+// deref returns a slice of values from a slice of pointers.
+func deref[T any](items []*T) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		result = append(result, *item)
+	}
+	return result
+}
+
+// labelsOrEmpty returns the labels, or an empty map if the labels are nil.
+func labelsOrEmpty(labels *map[string]string) map[string]string {
+	if labels == nil || *labels == nil {
+		return map[string]string{}
+	}
+	return maps.Clone(*labels)
+}
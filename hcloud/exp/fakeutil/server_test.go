@@ -0,0 +1,309 @@
+package fakeutil_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/fakeutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sshutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func newClient(t *testing.T, opts ...fakeutil.Option) (*fakeutil.Server, *hcloud.Client) {
+	t.Helper()
+
+	server := fakeutil.NewServer(t, opts...)
+	client := hcloud.NewClient(
+		hcloud.WithEndpoint(server.URL),
+		hcloud.WithToken("token"),
+		hcloud.WithRetryOpts(hcloud.RetryOpts{BackoffFunc: hcloud.ConstantBackoff(0), MaxRetries: 3}),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(time.Millisecond)}),
+	)
+	return server, client
+}
+
+func mustParseCIDR(t *testing.T, value string) *net.IPNet {
+	t.Helper()
+
+	_, ipNet, err := net.ParseCIDR(value)
+	require.NoError(t, err)
+	return ipNet
+}
+
+func TestServerLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	_, publicKey, err := sshutil.GenerateKeyPair()
+	require.NoError(t, err)
+
+	sshKey, _, err := client.SSHKey.Create(ctx, hcloud.SSHKeyCreateOpts{Name: "key", PublicKey: string(publicKey)})
+	require.NoError(t, err)
+	assert.NotEmpty(t, sshKey.Fingerprint)
+
+	network, _, err := client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+		Name:    "network",
+		IPRange: mustParseCIDR(t, "10.0.0.0/16"),
+		Subnets: []hcloud.NetworkSubnet{{
+			Type:        hcloud.NetworkSubnetTypeCloud,
+			IPRange:     mustParseCIDR(t, "10.0.1.0/24"),
+			NetworkZone: hcloud.NetworkZoneEUCentral,
+		}},
+	})
+	require.NoError(t, err)
+
+	result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+		Name:       "server",
+		ServerType: &hcloud.ServerType{Name: "cpx22"},
+		Image:      &hcloud.Image{Name: "ubuntu-24.04"},
+		Location:   &hcloud.Location{Name: "fsn1"},
+		SSHKeys:    []*hcloud.SSHKey{sshKey},
+		Networks:   []*hcloud.Network{network},
+		Labels:     map[string]string{"env": "test"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusInitializing, result.Server.Status)
+	assert.Empty(t, result.RootPassword)
+
+	require.NoError(t, client.Action.WaitFor(ctx, append(result.NextActions, result.Action)...))
+
+	server, _, err := client.Server.GetByID(ctx, result.Server.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusRunning, server.Status)
+	assert.Equal(t, "fsn1", server.Location.Name)
+	require.NotNil(t, server.PublicNet.IPv4)
+	assert.NotNil(t, server.PublicNet.IPv4.IP)
+	require.Len(t, server.PrivateNet, 1)
+	assert.Equal(t, "10.0.1.2", server.PrivateNet[0].IP.String())
+
+	servers, err := client.Server.AllWithOpts(ctx, hcloud.ServerListOpts{
+		ListOpts: hcloud.ListOpts{LabelSelector: "env=test"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, servers, 1)
+
+	action, _, err := client.Server.Poweroff(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusOff, server.Status)
+
+	deleteResult, _, err := client.Server.DeleteWithResult(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, deleteResult.Action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Nil(t, server)
+
+	primaryIPs, err := client.PrimaryIP.All(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, primaryIPs)
+}
+
+func TestServerErrors(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newClient(t, fakeutil.WithToken("token"))
+
+	t.Run("unauthorized", func(t *testing.T) {
+		other := hcloud.NewClient(hcloud.WithEndpoint(fake.URL), hcloud.WithToken("invalid"))
+		_, _, err := other.Server.List(ctx, hcloud.ServerListOpts{})
+		assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeUnauthorized))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := client.Volume.Delete(ctx, &hcloud.Volume{ID: 1000})
+		assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeNotFound))
+	})
+
+	t.Run("uniqueness", func(t *testing.T) {
+		opts := hcloud.FirewallCreateOpts{Name: "firewall"}
+		_, _, err := client.Firewall.Create(ctx, opts)
+		require.NoError(t, err)
+		_, _, err = client.Firewall.Create(ctx, opts)
+		assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeUniquenessError))
+	})
+
+	t.Run("failed action", func(t *testing.T) {
+		fake.FailNextAction("create_volume", schema.ActionError{Code: "server_error", Message: "volume creation failed"})
+
+		result, _, err := client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+			Name:     "volume",
+			Size:     10,
+			Location: &hcloud.Location{Name: "nbg1"},
+		})
+		require.NoError(t, err)
+
+		err = client.Action.WaitFor(ctx, result.Action)
+		var actionErr hcloud.ActionError
+		require.ErrorAs(t, err, &actionErr)
+		assert.Equal(t, "server_error", actionErr.Code)
+	})
+}
+
+func TestServerPagination(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	for i := range 60 {
+		_, _, err := client.Firewall.Create(ctx, hcloud.FirewallCreateOpts{Name: "firewall-" + string(rune('a'+i/26)) + string(rune('a'+i%26))})
+		require.NoError(t, err)
+	}
+
+	firewalls, resp, err := client.Firewall.List(ctx, hcloud.FirewallListOpts{ListOpts: hcloud.ListOpts{PerPage: 50}})
+	require.NoError(t, err)
+	assert.Len(t, firewalls, 50)
+	assert.Equal(t, 2, resp.Meta.Pagination.NextPage)
+
+	firewalls, err = client.Firewall.All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, firewalls, 60)
+}
+
+func TestServerZones(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	result, _, err := client.Zone.Create(ctx, hcloud.ZoneCreateOpts{
+		Name: "example.com",
+		Mode: hcloud.ZoneModePrimary,
+		RRSets: []hcloud.ZoneCreateOptsRRSet{
+			{Name: "www", Type: hcloud.ZoneRRSetTypeA, Records: []hcloud.ZoneRRSetRecord{{Value: "198.51.100.1"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	zone, _, err := client.Zone.Get(ctx, "example.com")
+	require.NoError(t, err)
+	require.NotNil(t, zone)
+
+	rrset := &hcloud.ZoneRRSet{Zone: zone, Name: "www", Type: hcloud.ZoneRRSetTypeA}
+	action, _, err := client.Zone.AddRRSetRecords(ctx, rrset, hcloud.ZoneRRSetAddRecordsOpts{
+		Records: []hcloud.ZoneRRSetRecord{{Value: "198.51.100.2"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	rrset, _, err = client.Zone.GetRRSetByNameAndType(ctx, zone, "www", hcloud.ZoneRRSetTypeA)
+	require.NoError(t, err)
+	assert.Len(t, rrset.Records, 2)
+
+	rrsets, err := client.Zone.AllRRSets(ctx, zone)
+	require.NoError(t, err)
+	assert.Len(t, rrsets, 3)
+
+	export, _, err := client.Zone.ExportZonefile(ctx, zone)
+	require.NoError(t, err)
+	assert.Contains(t, export.Zonefile, "$ORIGIN example.com.")
+	assert.Contains(t, export.Zonefile, "www IN A 198.51.100.2")
+
+	action, _, err = client.Zone.RemoveRRSetRecords(ctx, rrset, hcloud.ZoneRRSetRemoveRecordsOpts{
+		Records: rrset.Records,
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	rrset, _, err = client.Zone.GetRRSetByNameAndType(ctx, zone, "www", hcloud.ZoneRRSetTypeA)
+	require.NoError(t, err)
+	assert.Nil(t, rrset)
+}
+
+func TestServerZonesImportZonefile(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	result, _, err := client.Zone.Create(ctx, hcloud.ZoneCreateOpts{
+		Name:     "example.com",
+		Mode:     hcloud.ZoneModePrimary,
+		Zonefile: "$TTL 600\n@ IN NS hydrogen.ns.hetzner.com.\nwww 300 IN A 198.51.100.1\n",
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+	assert.Equal(t, 600, result.Zone.TTL)
+
+	action, _, err := client.Zone.ImportZonefile(ctx, result.Zone, hcloud.ZoneImportZonefileOpts{
+		Zonefile: "$ORIGIN example.com.\nmail IN TXT hello ; comment\n",
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	rrsets, err := client.Zone.AllRRSets(ctx, result.Zone)
+	require.NoError(t, err)
+	require.Len(t, rrsets, 2)
+	assert.Equal(t, hcloud.ZoneRRSetTypeSOA, rrsets[0].Type)
+	assert.Equal(t, []hcloud.ZoneRRSetRecord{{Value: `"hello"`, Comment: "comment"}}, rrsets[1].Records)
+
+	_, _, err = client.Zone.ImportZonefile(ctx, result.Zone, hcloud.ZoneImportZonefileOpts{
+		Zonefile: "www.example.org. IN A 198.51.100.1\n",
+	})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeInvalidInput))
+}
+
+func TestServerConcurrentRequests(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	network, _, err := client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+		Name:    "network",
+		IPRange: mustParseCIDR(t, "10.0.0.0/16"),
+		Subnets: []hcloud.NetworkSubnet{{
+			Type:        hcloud.NetworkSubnetTypeCloud,
+			IPRange:     mustParseCIDR(t, "10.0.1.0/24"),
+			NetworkZone: hcloud.NetworkZoneEUCentral,
+		}},
+	})
+	require.NoError(t, err)
+
+	// Read the network while servers are attached to and detached from it
+	done := make(chan struct{})
+	readers := sync.WaitGroup{}
+	for range 3 {
+		readers.Go(func() {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				_, _, err := client.Network.GetByID(ctx, network.ID)
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	writers := sync.WaitGroup{}
+	for i := range 20 {
+		writers.Go(func() {
+			result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+				Name:       fmt.Sprintf("server-%d", i),
+				ServerType: &hcloud.ServerType{Name: "cpx22"},
+				Image:      &hcloud.Image{Name: "ubuntu-24.04"},
+				Location:   &hcloud.Location{Name: "fsn1"},
+				Networks:   []*hcloud.Network{network},
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, _, err = client.Server.DeleteWithResult(ctx, result.Server)
+			assert.NoError(t, err)
+		})
+	}
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	network, _, err = client.Network.GetByID(ctx, network.ID)
+	require.NoError(t, err)
+	assert.Empty(t, network.Servers)
+}
@@ -0,0 +1,445 @@
+package fakeutil
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// nameInUse reports whether an item of the map already uses the given name.
+func nameInUse[T any](items map[int64]*T, name string, nameOf func(*T) string) bool {
+	for _, item := range items {
+		if nameOf(item) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) registerServers() {
+	s.handle("GET /servers", func(r *http.Request) (int, any, error) {
+		query := r.URL.Query()
+		result := []schema.Server{}
+		for _, o := range sorted(s.servers) {
+			if query.Has("status") && !slices.Contains(query["status"], o.Status) {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "servers", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /servers/{id}", func(r *http.Request) (int, any, error) {
+		server, err := lookup(r, s.servers, "server")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.ServerGetResponse{Server: *server}, nil
+	})
+
+	s.handle("POST /servers", s.createServer)
+
+	s.handle("PUT /servers/{id}", func(r *http.Request) (int, any, error) {
+		server, err := lookup(r, s.servers, "server")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.ServerUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != "" && req.Name != server.Name {
+			if nameInUse(s.servers, req.Name, func(o *schema.Server) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			server.Name = req.Name
+		}
+		if req.Labels != nil {
+			server.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.ServerUpdateResponse{Server: *server}, nil
+	})
+
+	s.handle("DELETE /servers/{id}", func(r *http.Request) (int, any, error) {
+		server, err := lookup(r, s.servers, "server")
+		if err != nil {
+			return 0, nil, err
+		}
+		if server.Protection.Delete {
+			return 0, nil, errProtected("server")
+		}
+		s.deleteServer(server)
+		action := s.newAction("delete_server", nil, ref("server", server.ID))
+		return http.StatusOK, schema.ServerDeleteResponse{Action: action}, nil
+	})
+
+	s.registerResourceActions("/servers", "server", map[string]handlerFunc{
+		"poweron":        s.serverPowerAction("start_server", "running"),
+		"poweroff":       s.serverPowerAction("stop_server", "off"),
+		"shutdown":       s.serverPowerAction("shutdown_server", "off"),
+		"reboot":         s.serverPowerAction("reboot_server", "running"),
+		"reset":          s.serverPowerAction("reset_server", "running"),
+		"enable_backup":  s.serverBackupAction("enable_backup", true),
+		"disable_backup": s.serverBackupAction("disable_backup", false),
+
+		"reset_password": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			action := s.newAction("reset_password", nil, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionResetPasswordResponse{Action: action, RootPassword: randutil.GenerateID()}, nil
+		},
+
+		"enable_rescue": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			action := s.newAction("enable_rescue", func() { server.RescueEnabled = true }, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionEnableRescueResponse{Action: action, RootPassword: randutil.GenerateID()}, nil
+		},
+
+		"disable_rescue": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			action := s.newAction("disable_rescue", func() { server.RescueEnabled = false }, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionDisableRescueResponse{Action: action}, nil
+		},
+
+		"change_protection": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.ServerActionChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if req.Delete != nil {
+				server.Protection.Delete = *req.Delete
+			}
+			if req.Rebuild != nil {
+				server.Protection.Rebuild = *req.Rebuild
+			}
+			action := s.newAction("change_protection", nil, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionChangeProtectionResponse{Action: action}, nil
+		},
+
+		"change_type": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.ServerActionChangeTypeRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			serverType, ok := s.serverType(req.ServerType)
+			if !ok {
+				return 0, nil, errInvalidInput("server_type", "server type not found")
+			}
+			action := s.newAction("change_server_type", func() { server.ServerType = serverType }, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionChangeTypeResponse{Action: action}, nil
+		},
+
+		"rebuild": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			if server.Protection.Rebuild {
+				return 0, nil, errProtected("server")
+			}
+			var req schema.ServerActionRebuildRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			image, ok := s.image(req.Image, server.ServerType.Architecture)
+			if !ok {
+				return 0, nil, errInvalidInput("image", "image not found")
+			}
+			action := s.newAction("rebuild_server", func() { server.Image = &image }, ref("server", server.ID))
+			rootPassword := randutil.GenerateID()
+			return http.StatusCreated, schema.ServerActionRebuildResponse{Action: action, RootPassword: &rootPassword}, nil
+		},
+
+		"attach_to_network": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.ServerActionAttachToNetworkRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			network, ok := s.networks[req.Network]
+			if !ok {
+				return 0, nil, errNotFound("network")
+			}
+			if slices.Contains(network.Servers, server.ID) {
+				return 0, nil, &apiError{status: http.StatusUnprocessableEntity, code: "server_already_attached", message: "server is already attached to the network"}
+			}
+			ip := s.allocatePrivateIP(network)
+			if req.IP != nil {
+				ip = *req.IP
+			}
+			s.attachServerToNetwork(server, network, ip)
+			action := s.newAction("attach_to_network", nil, ref("server", server.ID), ref("network", network.ID))
+			return http.StatusCreated, schema.ServerActionAttachToNetworkResponse{Action: action}, nil
+		},
+
+		"detach_from_network": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.ServerActionDetachFromNetworkRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			network, ok := s.networks[req.Network]
+			if !ok {
+				return 0, nil, errNotFound("network")
+			}
+			s.detachServerFromNetwork(server, network)
+			action := s.newAction("detach_from_network", nil, ref("server", server.ID), ref("network", network.ID))
+			return http.StatusCreated, schema.ServerActionDetachFromNetworkResponse{Action: action}, nil
+		},
+
+		"change_dns_ptr": func(r *http.Request) (int, any, error) {
+			server, err := lookup(r, s.servers, "server")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.ServerActionChangeDNSPtrRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if req.IP != server.PublicNet.IPv4.IP {
+				return 0, nil, errInvalidInput("ip", "ip does not belong to the server")
+			}
+			server.PublicNet.IPv4.DNSPtr = ""
+			if req.DNSPtr != nil {
+				server.PublicNet.IPv4.DNSPtr = *req.DNSPtr
+			}
+			action := s.newAction("change_dns_ptr", nil, ref("server", server.ID))
+			return http.StatusCreated, schema.ServerActionChangeDNSPtrResponse{Action: action}, nil
+		},
+	})
+}
+
+func (s *Server) serverPowerAction(command, status string) handlerFunc {
+	return func(r *http.Request) (int, any, error) {
+		server, err := lookup(r, s.servers, "server")
+		if err != nil {
+			return 0, nil, err
+		}
+		action := s.newAction(command, func() { server.Status = status }, ref("server", server.ID))
+		return http.StatusCreated, schema.ServerActionPoweronResponse{Action: action}, nil
+	}
+}
+
+func (s *Server) serverBackupAction(command string, enabled bool) handlerFunc {
+	return func(r *http.Request) (int, any, error) {
+		server, err := lookup(r, s.servers, "server")
+		if err != nil {
+			return 0, nil, err
+		}
+		action := s.newAction(command, func() {
+			server.BackupWindow = nil
+			if enabled {
+				window := "22-02"
+				server.BackupWindow = &window
+			}
+		}, ref("server", server.ID))
+		return http.StatusCreated, schema.ServerActionEnableBackupResponse{Action: action}, nil
+	}
+}
+
+func (s *Server) createServer(r *http.Request) (int, any, error) {
+	var req schema.ServerCreateRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+
+	if req.Name == "" {
+		return 0, nil, errInvalidInput("name", "is required")
+	}
+	if nameInUse(s.servers, req.Name, func(o *schema.Server) string { return o.Name }) {
+		return 0, nil, errUniqueness("name")
+	}
+	serverType, ok := s.serverType(req.ServerType)
+	if !ok {
+		return 0, nil, errInvalidInput("server_type", "server type not found")
+	}
+	image, ok := s.image(req.Image, serverType.Architecture)
+	if !ok {
+		return 0, nil, errInvalidInput("image", "image not found")
+	}
+	locationName := req.Location
+	if locationName == "" {
+		locationName = "fsn1"
+	}
+	location, ok := s.location(locationName)
+	if !ok {
+		return 0, nil, errInvalidInput("location", "location not found")
+	}
+	for _, id := range req.SSHKeys {
+		if _, ok := s.sshKeys[id]; !ok {
+			return 0, nil, errInvalidInput("ssh_keys", fmt.Sprintf("ssh key %d not found", id))
+		}
+	}
+	for _, id := range req.Volumes {
+		volume, ok := s.volumes[id]
+		if !ok {
+			return 0, nil, errInvalidInput("volumes", fmt.Sprintf("volume %d not found", id))
+		}
+		if volume.Server != nil {
+			return 0, nil, errInvalidInput("volumes", fmt.Sprintf("volume %d is already attached", id))
+		}
+	}
+	for _, id := range req.Networks {
+		if _, ok := s.networks[id]; !ok {
+			return 0, nil, errInvalidInput("networks", fmt.Sprintf("network %d not found", id))
+		}
+	}
+	for _, o := range req.Firewalls {
+		if _, ok := s.firewalls[o.Firewall]; !ok {
+			return 0, nil, errInvalidInput("firewalls", fmt.Sprintf("firewall %d not found", o.Firewall))
+		}
+	}
+
+	publicNet := schema.ServerCreatePublicNet{EnableIPv4: true, EnableIPv6: true}
+	if req.PublicNet != nil {
+		publicNet = *req.PublicNet
+	}
+	for _, id := range []int64{publicNet.IPv4ID, publicNet.IPv6ID} {
+		if id == 0 {
+			continue
+		}
+		primaryIP, ok := s.primaryIPs[id]
+		if !ok {
+			return 0, nil, errInvalidInput("public_net", fmt.Sprintf("primary ip %d not found", id))
+		}
+		if primaryIP.AssigneeID != nil {
+			return 0, nil, errInvalidInput("public_net", fmt.Sprintf("primary ip %d is already assigned", id))
+		}
+	}
+
+	server := &schema.Server{
+		ID:              s.nextID(),
+		Name:            req.Name,
+		Status:          "initializing",
+		Created:         time.Now().UTC(),
+		ServerType:      serverType,
+		IncludedTraffic: 21990232555520,
+		Location:        location,
+		Image:           &image,
+		Labels:          labelsOrEmpty(req.Labels),
+		Volumes:         []int64{},
+		PrivateNet:      []schema.ServerPrivateNet{},
+		LoadBalancers:   []int64{},
+		PrimaryDiskSize: serverType.Disk,
+		PublicNet: schema.ServerPublicNet{
+			FloatingIPs: []int64{},
+			Firewalls:   []schema.ServerFirewall{},
+		},
+	}
+	s.servers[server.ID] = server
+
+	if publicNet.EnableIPv4 {
+		primaryIP := s.primaryIPs[publicNet.IPv4ID]
+		if primaryIP == nil {
+			primaryIP = s.newPrimaryIP("", "ipv4", location, true)
+		}
+		s.assignPrimaryIP(primaryIP, server)
+	}
+	if publicNet.EnableIPv6 {
+		primaryIP := s.primaryIPs[publicNet.IPv6ID]
+		if primaryIP == nil {
+			primaryIP = s.newPrimaryIP("", "ipv6", location, true)
+		}
+		s.assignPrimaryIP(primaryIP, server)
+	}
+	for _, id := range req.Volumes {
+		s.attachVolume(s.volumes[id], server)
+	}
+	for _, id := range req.Networks {
+		network := s.networks[id]
+		s.attachServerToNetwork(server, network, s.allocatePrivateIP(network))
+	}
+	for _, o := range req.Firewalls {
+		s.applyFirewall(s.firewalls[o.Firewall], schema.FirewallResource{
+			Type:   "server",
+			Server: &schema.FirewallResourceServer{ID: server.ID},
+		})
+	}
+
+	start := req.StartAfterCreate == nil || *req.StartAfterCreate
+
+	resp := schema.ServerCreateResponse{
+		Server:      *server,
+		NextActions: []schema.Action{},
+	}
+	resp.Action = s.newAction("create_server", func() {
+		if start {
+			server.Status = "running"
+		} else {
+			server.Status = "off"
+		}
+	}, ref("server", server.ID))
+	if start {
+		resp.NextActions = append(resp.NextActions, s.newAction("start_server", nil, ref("server", server.ID)))
+	}
+	if len(req.SSHKeys) == 0 {
+		rootPassword := randutil.GenerateID()
+		resp.RootPassword = &rootPassword
+	}
+
+	return http.StatusCreated, resp, nil
+}
+
+// deleteServer removes the server, and releases the resources it uses.
+func (s *Server) deleteServer(server *schema.Server) {
+	for _, id := range []int64{server.PublicNet.IPv4.ID, server.PublicNet.IPv6.ID} {
+		if primaryIP, ok := s.primaryIPs[id]; ok {
+			if primaryIP.AutoDelete {
+				delete(s.primaryIPs, id)
+			} else {
+				primaryIP.AssigneeID = nil
+			}
+		}
+	}
+	for _, id := range server.Volumes {
+		if volume, ok := s.volumes[id]; ok {
+			volume.Server = nil
+			volume.LinuxDevice = ""
+		}
+	}
+	for _, o := range server.PrivateNet {
+		if network, ok := s.networks[o.Network]; ok {
+			network.Servers = slices.DeleteFunc(network.Servers, func(id int64) bool { return id == server.ID })
+		}
+	}
+	for _, firewall := range s.firewalls {
+		firewall.AppliedTo = slices.DeleteFunc(firewall.AppliedTo, func(o schema.FirewallResource) bool {
+			return o.Type == "server" && o.Server != nil && o.Server.ID == server.ID
+		})
+	}
+	for _, loadBalancer := range s.loadBalancers {
+		loadBalancer.Targets = slices.DeleteFunc(loadBalancer.Targets, func(o schema.LoadBalancerTarget) bool {
+			return o.Type == "server" && o.Server != nil && o.Server.ID == server.ID
+		})
+	}
+	delete(s.servers, server.ID)
+}
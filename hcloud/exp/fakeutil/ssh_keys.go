@@ -0,0 +1,99 @@
+package fakeutil
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerSSHKeys() {
+	s.handle("GET /ssh_keys", func(r *http.Request) (int, any, error) {
+		query := r.URL.Query()
+		result := []schema.SSHKey{}
+		for _, o := range sorted(s.sshKeys) {
+			if query.Has("fingerprint") && query.Get("fingerprint") != o.Fingerprint {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "ssh_keys", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /ssh_keys/{id}", func(r *http.Request) (int, any, error) {
+		sshKey, err := lookup(r, s.sshKeys, "ssh_key")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.SSHKeyGetResponse{SSHKey: *sshKey}, nil
+	})
+
+	s.handle("POST /ssh_keys", func(r *http.Request) (int, any, error) {
+		var req schema.SSHKeyCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.PublicKey))
+		if err != nil {
+			return 0, nil, errInvalidInput("public_key", "invalid public key")
+		}
+		fingerprint := ssh.FingerprintLegacyMD5(publicKey)
+		if nameInUse(s.sshKeys, req.Name, func(o *schema.SSHKey) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+		if nameInUse(s.sshKeys, fingerprint, func(o *schema.SSHKey) string { return o.Fingerprint }) {
+			return 0, nil, errUniqueness("public_key")
+		}
+
+		sshKey := &schema.SSHKey{
+			ID:          s.nextID(),
+			Name:        req.Name,
+			Fingerprint: fingerprint,
+			PublicKey:   strings.TrimSpace(req.PublicKey),
+			Labels:      labelsOrEmpty(req.Labels),
+			Created:     time.Now().UTC(),
+		}
+		s.sshKeys[sshKey.ID] = sshKey
+
+		return http.StatusCreated, schema.SSHKeyCreateResponse{SSHKey: *sshKey}, nil
+	})
+
+	s.handle("PUT /ssh_keys/{id}", func(r *http.Request) (int, any, error) {
+		sshKey, err := lookup(r, s.sshKeys, "ssh_key")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.SSHKeyUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != "" && req.Name != sshKey.Name {
+			if nameInUse(s.sshKeys, req.Name, func(o *schema.SSHKey) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			sshKey.Name = req.Name
+		}
+		if req.Labels != nil {
+			sshKey.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.SSHKeyUpdateResponse{SSHKey: *sshKey}, nil
+	})
+
+	s.handle("DELETE /ssh_keys/{id}", func(r *http.Request) (int, any, error) {
+		sshKey, err := lookup(r, s.sshKeys, "ssh_key")
+		if err != nil {
+			return 0, nil, err
+		}
+		delete(s.sshKeys, sshKey.ID)
+		return http.StatusNoContent, nil, nil
+	})
+}
@@ -0,0 +1,224 @@
+package fakeutil
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// attachVolume attaches the volume to the server.
+func (s *Server) attachVolume(volume *schema.Volume, server *schema.Server) {
+	volume.Server = &server.ID
+	server.Volumes = append(server.Volumes, volume.ID)
+}
+
+// detachVolume detaches the volume from its server, if any.
+func (s *Server) detachVolume(volume *schema.Volume) {
+	if volume.Server == nil {
+		return
+	}
+	if server, ok := s.servers[*volume.Server]; ok {
+		server.Volumes = slices.DeleteFunc(server.Volumes, func(id int64) bool { return id == volume.ID })
+	}
+	volume.Server = nil
+}
+
+func (s *Server) registerVolumes() {
+	s.handle("GET /volumes", func(r *http.Request) (int, any, error) {
+		query := r.URL.Query()
+		result := []schema.Volume{}
+		for _, o := range sorted(s.volumes) {
+			if query.Has("status") && !slices.Contains(query["status"], o.Status) {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "volumes", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /volumes/{id}", func(r *http.Request) (int, any, error) {
+		volume, err := lookup(r, s.volumes, "volume")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.VolumeGetResponse{Volume: *volume}, nil
+	})
+
+	s.handle("POST /volumes", func(r *http.Request) (int, any, error) {
+		var req schema.VolumeCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		if req.Size < 10 {
+			return 0, nil, errInvalidInput("size", "must be at least 10")
+		}
+		if nameInUse(s.volumes, req.Name, func(o *schema.Volume) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+
+		var server *schema.Server
+		var location schema.Location
+		switch {
+		case req.Server != nil:
+			var ok bool
+			server, ok = s.servers[*req.Server]
+			if !ok {
+				return 0, nil, errInvalidInput("server", "server not found")
+			}
+			location = server.Location
+		case req.Location != nil:
+			idOrName := req.Location.Name
+			if idOrName == "" {
+				idOrName = fmt.Sprint(req.Location.ID)
+			}
+			var ok bool
+			location, ok = s.location(idOrName)
+			if !ok {
+				return 0, nil, errInvalidInput("location", "location not found")
+			}
+		default:
+			return 0, nil, errInvalidInput("location", "location or server is required")
+		}
+
+		id := s.nextID()
+		volume := &schema.Volume{
+			ID:          id,
+			Name:        req.Name,
+			Status:      "creating",
+			Location:    location,
+			Size:        req.Size,
+			Format:      req.Format,
+			Labels:      labelsOrEmpty(req.Labels),
+			LinuxDevice: fmt.Sprintf("/dev/disk/by-id/scsi-0HC_Volume_%d", id),
+			Created:     time.Now().UTC(),
+		}
+		s.volumes[volume.ID] = volume
+
+		resp := schema.VolumeCreateResponse{NextActions: []schema.Action{}}
+		action := s.newAction("create_volume", func() { volume.Status = "available" }, ref("volume", volume.ID))
+		resp.Action = &action
+		if server != nil {
+			s.attachVolume(volume, server)
+			resp.NextActions = append(resp.NextActions, s.newAction("attach_volume", nil, ref("volume", volume.ID), ref("server", server.ID)))
+		}
+		resp.Volume = *volume
+		return http.StatusCreated, resp, nil
+	})
+
+	s.handle("PUT /volumes/{id}", func(r *http.Request) (int, any, error) {
+		volume, err := lookup(r, s.volumes, "volume")
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.VolumeUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name != "" && req.Name != volume.Name {
+			if nameInUse(s.volumes, req.Name, func(o *schema.Volume) string { return o.Name }) {
+				return 0, nil, errUniqueness("name")
+			}
+			volume.Name = req.Name
+		}
+		if req.Labels != nil {
+			volume.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.VolumeUpdateResponse{Volume: *volume}, nil
+	})
+
+	s.handle("DELETE /volumes/{id}", func(r *http.Request) (int, any, error) {
+		volume, err := lookup(r, s.volumes, "volume")
+		if err != nil {
+			return 0, nil, err
+		}
+		if volume.Protection.Delete {
+			return 0, nil, errProtected("volume")
+		}
+		if volume.Server != nil {
+			return 0, nil, errResourceInUse("volume is attached to a server")
+		}
+		delete(s.volumes, volume.ID)
+		return http.StatusNoContent, nil, nil
+	})
+
+	s.registerResourceActions("/volumes", "volume", map[string]handlerFunc{
+		"attach": func(r *http.Request) (int, any, error) {
+			volume, err := lookup(r, s.volumes, "volume")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.VolumeActionAttachVolumeRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			server, ok := s.servers[req.Server]
+			if !ok {
+				return 0, nil, errInvalidInput("server", "server not found")
+			}
+			if volume.Server != nil {
+				return 0, nil, errResourceInUse("volume is already attached to a server")
+			}
+			if server.Location.ID != volume.Location.ID {
+				return 0, nil, errInvalidInput("server", "server is in a different location")
+			}
+			s.attachVolume(volume, server)
+			action := s.newAction("attach_volume", nil, ref("volume", volume.ID), ref("server", server.ID))
+			return http.StatusCreated, schema.VolumeActionAttachVolumeResponse{Action: action}, nil
+		},
+
+		"detach": func(r *http.Request) (int, any, error) {
+			volume, err := lookup(r, s.volumes, "volume")
+			if err != nil {
+				return 0, nil, err
+			}
+			resources := []schema.ActionResourceReference{ref("volume", volume.ID)}
+			if volume.Server != nil {
+				resources = append(resources, ref("server", *volume.Server))
+			}
+			s.detachVolume(volume)
+			action := s.newAction("detach_volume", nil, resources...)
+			return http.StatusCreated, schema.VolumeActionDetachVolumeResponse{Action: action}, nil
+		},
+
+		"resize": func(r *http.Request) (int, any, error) {
+			volume, err := lookup(r, s.volumes, "volume")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.VolumeActionResizeVolumeRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if req.Size < volume.Size {
+				return 0, nil, errInvalidInput("size", "must be greater than the current size")
+			}
+			action := s.newAction("resize_volume", func() { volume.Size = req.Size }, ref("volume", volume.ID))
+			return http.StatusCreated, schema.VolumeActionResizeVolumeResponse{Action: action}, nil
+		},
+
+		"change_protection": func(r *http.Request) (int, any, error) {
+			volume, err := lookup(r, s.volumes, "volume")
+			if err != nil {
+				return 0, nil, err
+			}
+			var req schema.VolumeActionChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return 0, nil, err
+			}
+			if req.Delete != nil {
+				volume.Protection.Delete = *req.Delete
+			}
+			action := s.newAction("change_protection", nil, ref("volume", volume.ID))
+			return http.StatusCreated, schema.VolumeActionChangeProtectionResponse{Action: action}, nil
+		},
+	})
+}
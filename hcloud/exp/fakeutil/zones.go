@@ -0,0 +1,514 @@
+package fakeutil
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/zoneutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// lookupZone returns the zone identified by the "id" path value, which may be an ID
+// or a name.
+func (s *Server) lookupZone(r *http.Request) (*schema.Zone, error) {
+	zone, ok := s.zones[s.resourceID("zone", r.PathValue("id"))]
+	if !ok {
+		return nil, errNotFound("zone")
+	}
+	return zone, nil
+}
+
+// lookupRRSet returns the RRSet identified by the "name" and "type" path values.
+func (s *Server) lookupRRSet(r *http.Request) (*schema.Zone, *schema.ZoneRRSet, error) {
+	zone, err := s.lookupZone(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	rrset, ok := s.rrsets[zone.ID][r.PathValue("name")+"/"+r.PathValue("type")]
+	if !ok {
+		return nil, nil, errNotFound("rrset")
+	}
+	return zone, rrset, nil
+}
+
+// putRRSet stores the RRSet in the zone, and updates the zone record count.
+func (s *Server) putRRSet(zone *schema.Zone, rrset *schema.ZoneRRSet) {
+	rrset.ID = rrset.Name + "/" + rrset.Type
+	rrset.Zone = zone.ID
+	s.rrsets[zone.ID][rrset.ID] = rrset
+	s.countRecords(zone)
+}
+
+func (s *Server) deleteRRSet(zone *schema.Zone, rrset *schema.ZoneRRSet) {
+	delete(s.rrsets[zone.ID], rrset.ID)
+	s.countRecords(zone)
+}
+
+func (s *Server) countRecords(zone *schema.Zone) {
+	zone.RecordCount = 0
+	for _, rrset := range s.rrsets[zone.ID] {
+		zone.RecordCount += len(rrset.Records)
+	}
+}
+
+// sortedRRSets returns the RRSets of the zone ordered by name and type.
+func (s *Server) sortedRRSets(zone *schema.Zone) []*schema.ZoneRRSet {
+	result := make([]*schema.ZoneRRSet, 0, len(s.rrsets[zone.ID]))
+	for _, rrset := range s.rrsets[zone.ID] {
+		result = append(result, rrset)
+	}
+	slices.SortFunc(result, func(a, b *schema.ZoneRRSet) int { return strings.Compare(a.ID, b.ID) })
+	return result
+}
+
+// zonefile returns the zone in BIND format.
+func (s *Server) zonefile(zone *schema.Zone) string {
+	result := &zoneutil.Zonefile{Origin: zone.Name, TTL: &zone.TTL}
+	for _, rrset := range s.sortedRRSets(zone) {
+		result.RRSets = append(result.RRSets, hcloud.ZoneRRSetFromSchema(*rrset))
+	}
+	return zoneutil.FormatZonefile(result)
+}
+
+// parseZonefile parses the zone file of the zone with the given name.
+func parseZonefile(name, data string) (*zoneutil.Zonefile, error) {
+	zonefile, err := zoneutil.ParseZonefile(data, name)
+	if err != nil {
+		return nil, errInvalidInput("zonefile", err.Error())
+	}
+	return zonefile, nil
+}
+
+// importZonefile replaces the RRSets of the zone with the RRSets of the zone file. The
+// SOA RRSet is kept when the zone file does not define one.
+func (s *Server) importZonefile(zone *schema.Zone, zonefile *zoneutil.Zonefile) {
+	soa, hasSOA := s.rrsets[zone.ID]["@/SOA"]
+
+	s.rrsets[zone.ID] = map[string]*schema.ZoneRRSet{}
+	if hasSOA {
+		s.rrsets[zone.ID][soa.ID] = soa
+	}
+
+	if zonefile.TTL != nil {
+		zone.TTL = *zonefile.TTL
+	}
+	for _, o := range zonefile.RRSets {
+		records := make([]schema.ZoneRRSetRecord, 0, len(o.Records))
+		for _, record := range o.Records {
+			records = append(records, schema.ZoneRRSetRecord{Value: record.Value, Comment: record.Comment})
+		}
+		s.putRRSet(zone, &schema.ZoneRRSet{Name: o.Name, Type: string(o.Type), TTL: o.TTL, Labels: map[string]string{}, Records: records})
+	}
+	s.countRecords(zone)
+}
+
+// mergeRecords adds the records to the list, skipping the records with an existing
+// value.
+func mergeRecords(records []schema.ZoneRRSetRecord, added []schema.ZoneRRSetRecord) []schema.ZoneRRSetRecord {
+	for _, record := range added {
+		if !slices.ContainsFunc(records, func(o schema.ZoneRRSetRecord) bool { return o.Value == record.Value }) {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (s *Server) registerZones() {
+	s.handle("GET /zones", func(r *http.Request) (int, any, error) {
+		query := r.URL.Query()
+		result := []schema.Zone{}
+		for _, o := range sorted(s.zones) {
+			if query.Has("mode") && query.Get("mode") != o.Mode {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "zones", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /zones/{id}", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.ZoneGetResponse{Zone: *zone}, nil
+	})
+
+	s.handle("POST /zones", func(r *http.Request) (int, any, error) {
+		var req schema.ZoneCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" {
+			return 0, nil, errInvalidInput("name", "is required")
+		}
+		if req.Mode != "primary" && req.Mode != "secondary" {
+			return 0, nil, errInvalidInput("mode", "must be primary or secondary")
+		}
+		var zonefile *zoneutil.Zonefile
+		if req.Zonefile != "" {
+			if req.Mode != "primary" {
+				return 0, nil, errUnsupported("zone file import is only supported on primary zones")
+			}
+			var err error
+			if zonefile, err = parseZonefile(req.Name, req.Zonefile); err != nil {
+				return 0, nil, err
+			}
+		}
+		if nameInUse(s.zones, req.Name, func(o *schema.Zone) string { return o.Name }) {
+			return 0, nil, errUniqueness("name")
+		}
+
+		zone := &schema.Zone{
+			ID:                 s.nextID(),
+			Name:               req.Name,
+			Created:            time.Now().UTC(),
+			TTL:                3600,
+			Mode:               req.Mode,
+			PrimaryNameservers: []schema.ZonePrimaryNameserver{},
+			Labels:             labelsOrEmpty(req.Labels),
+			AuthoritativeNameservers: schema.ZoneAuthoritativeNameservers{
+				Assigned:         []string{"hydrogen.ns.hetzner.com.", "oxygen.ns.hetzner.com.", "helium.ns.hetzner.de."},
+				Delegated:        []string{},
+				DelegationStatus: "unknown",
+			},
+			Registrar: "other",
+			Status:    "ok",
+		}
+		if req.TTL != nil {
+			zone.TTL = *req.TTL
+		}
+		for _, o := range req.PrimaryNameservers {
+			zone.PrimaryNameservers = append(zone.PrimaryNameservers, schema.ZonePrimaryNameserver(o))
+		}
+		s.zones[zone.ID] = zone
+		s.rrsets[zone.ID] = map[string]*schema.ZoneRRSet{}
+
+		if zone.Mode == "primary" {
+			nameservers := make([]schema.ZoneRRSetRecord, 0, len(zone.AuthoritativeNameservers.Assigned))
+			for _, o := range zone.AuthoritativeNameservers.Assigned {
+				nameservers = append(nameservers, schema.ZoneRRSetRecord{Value: o})
+			}
+			s.putRRSet(zone, &schema.ZoneRRSet{Name: "@", Type: "NS", Labels: map[string]string{}, Records: nameservers})
+			s.putRRSet(zone, &schema.ZoneRRSet{Name: "@", Type: "SOA", Labels: map[string]string{}, Records: []schema.ZoneRRSetRecord{
+				{Value: "hydrogen.ns.hetzner.com. dns.hetzner.com. 2024010100 86400 10800 3600000 3600"},
+			}})
+		}
+		for _, o := range req.RRSets {
+			s.putRRSet(zone, &schema.ZoneRRSet{Name: o.Name, Type: o.Type, TTL: o.TTL, Labels: labelsOrEmpty(o.Labels), Records: o.Records})
+		}
+		if zonefile != nil {
+			s.importZonefile(zone, zonefile)
+		}
+
+		action := s.newAction("create_zone", nil, ref("zone", zone.ID))
+		return http.StatusCreated, schema.ZoneCreateResponse{Zone: *zone, Action: action}, nil
+	})
+
+	s.handle("PUT /zones/{id}", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.ZoneUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Labels != nil {
+			zone.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.ZoneUpdateResponse{Zone: *zone}, nil
+	})
+
+	s.handle("DELETE /zones/{id}", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		if zone.Protection.Delete {
+			return 0, nil, errProtected("zone")
+		}
+		delete(s.zones, zone.ID)
+		delete(s.rrsets, zone.ID)
+		action := s.newAction("delete_zone", nil, ref("zone", zone.ID))
+		return http.StatusCreated, schema.ActionGetResponse{Action: action}, nil
+	})
+
+	s.handle("GET /zones/{id}/zonefile", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.ZoneExportZonefileResponse{Zonefile: s.zonefile(zone)}, nil
+	})
+
+	// zoneAction wraps a zone action handler, and returns the action created with
+	// the given command.
+	zoneAction := func(command string, fn func(r *http.Request, zone *schema.Zone) error) handlerFunc {
+		return func(r *http.Request) (int, any, error) {
+			zone, err := s.lookupZone(r)
+			if err != nil {
+				return 0, nil, err
+			}
+			if err := fn(r, zone); err != nil {
+				return 0, nil, err
+			}
+			action := s.newAction(command, nil, ref("zone", zone.ID))
+			return http.StatusCreated, schema.ActionGetResponse{Action: action}, nil
+		}
+	}
+
+	s.registerResourceActions("/zones", "zone", map[string]handlerFunc{
+		"change_protection": zoneAction("change_protection", func(r *http.Request, zone *schema.Zone) error {
+			var req schema.ZoneChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.Delete != nil {
+				zone.Protection.Delete = *req.Delete
+			}
+			return nil
+		}),
+
+		"change_ttl": zoneAction("change_ttl", func(r *http.Request, zone *schema.Zone) error {
+			var req schema.ZoneChangeTTLRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			zone.TTL = req.TTL
+			return nil
+		}),
+
+		"change_primary_nameservers": zoneAction("change_primary_nameservers", func(r *http.Request, zone *schema.Zone) error {
+			var req schema.ZoneChangePrimaryNameserversRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if zone.Mode != "secondary" {
+				return errUnsupported("primary nameservers can only be changed on secondary zones")
+			}
+			zone.PrimaryNameservers = []schema.ZonePrimaryNameserver{}
+			for _, o := range req.PrimaryNameservers {
+				zone.PrimaryNameservers = append(zone.PrimaryNameservers, schema.ZonePrimaryNameserver(o))
+			}
+			return nil
+		}),
+
+		"import_zonefile": zoneAction("import_zonefile", func(r *http.Request, zone *schema.Zone) error {
+			var req schema.ZoneImportZonefileRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if zone.Mode != "primary" {
+				return errUnsupported("zone file import is only supported on primary zones")
+			}
+			zonefile, err := parseZonefile(zone.Name, req.Zonefile)
+			if err != nil {
+				return err
+			}
+			s.importZonefile(zone, zonefile)
+			return nil
+		}),
+	})
+
+	s.registerRRSets()
+}
+
+func (s *Server) registerRRSets() {
+	s.handle("GET /zones/{id}/rrsets", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		query := r.URL.Query()
+		result := []schema.ZoneRRSet{}
+		for _, o := range s.sortedRRSets(zone) {
+			if query.Has("type") && !slices.Contains(query["type"], o.Type) {
+				continue
+			}
+			if filterCommon(r, o.Name, o.Labels) {
+				result = append(result, *o)
+			}
+		}
+		body, err := paginate(r, "rrsets", result)
+		return http.StatusOK, body, err
+	})
+
+	s.handle("GET /zones/{id}/rrsets/{name}/{type}", func(r *http.Request) (int, any, error) {
+		_, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, schema.ZoneRRSetGetResponse{RRSet: *rrset}, nil
+	})
+
+	s.handle("POST /zones/{id}/rrsets", func(r *http.Request) (int, any, error) {
+		zone, err := s.lookupZone(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.ZoneRRSetCreateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Name == "" || req.Type == "" {
+			return 0, nil, errInvalidInput("name", "name and type are required")
+		}
+		if _, ok := s.rrsets[zone.ID][req.Name+"/"+req.Type]; ok {
+			return 0, nil, errUniqueness("name")
+		}
+		rrset := &schema.ZoneRRSet{
+			Name:    req.Name,
+			Type:    req.Type,
+			TTL:     req.TTL,
+			Labels:  labelsOrEmpty(req.Labels),
+			Records: append([]schema.ZoneRRSetRecord{}, req.Records...),
+		}
+		s.putRRSet(zone, rrset)
+		action := s.newAction("create_rrset", nil, ref("zone", zone.ID))
+		return http.StatusCreated, schema.ZoneRRSetCreateResponse{RRSet: *rrset, Action: action}, nil
+	})
+
+	s.handle("PUT /zones/{id}/rrsets/{name}/{type}", func(r *http.Request) (int, any, error) {
+		_, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		var req schema.ZoneRRSetUpdateRequest
+		if err := decode(r, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.Labels != nil {
+			rrset.Labels = labelsOrEmpty(req.Labels)
+		}
+		return http.StatusOK, schema.ZoneRRSetUpdateResponse{RRSet: *rrset}, nil
+	})
+
+	s.handle("DELETE /zones/{id}/rrsets/{name}/{type}", func(r *http.Request) (int, any, error) {
+		zone, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		if rrset.Protection.Change {
+			return 0, nil, errProtected("rrset")
+		}
+		s.deleteRRSet(zone, rrset)
+		action := s.newAction("delete_rrset", nil, ref("zone", zone.ID))
+		return http.StatusCreated, schema.ActionGetResponse{Action: action}, nil
+	})
+
+	// rrsetAction wraps a RRSet action handler, and returns the action created with
+	// the given command. Unless the action changes the protection, protected RRSets
+	// are rejected.
+	rrsetAction := func(command string, fn func(r *http.Request, zone *schema.Zone, rrset *schema.ZoneRRSet) error) func(r *http.Request, zone *schema.Zone, rrset *schema.ZoneRRSet) (int, any, error) {
+		return func(r *http.Request, zone *schema.Zone, rrset *schema.ZoneRRSet) (int, any, error) {
+			if rrset.Protection.Change && command != "change_rrset_protection" {
+				return 0, nil, errProtected("rrset")
+			}
+			if err := fn(r, zone, rrset); err != nil {
+				return 0, nil, err
+			}
+			if len(rrset.Records) == 0 && command != "change_rrset_protection" && command != "change_rrset_ttl" {
+				s.deleteRRSet(zone, rrset)
+			} else {
+				s.countRecords(zone)
+			}
+			action := s.newAction(command, nil, ref("zone", zone.ID))
+			return http.StatusCreated, schema.ActionGetResponse{Action: action}, nil
+		}
+	}
+
+	commands := map[string]func(r *http.Request, zone *schema.Zone, rrset *schema.ZoneRRSet) (int, any, error){
+		"change_protection": rrsetAction("change_rrset_protection", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetChangeProtectionRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.Change != nil {
+				rrset.Protection.Change = *req.Change
+			}
+			return nil
+		}),
+
+		"change_ttl": rrsetAction("change_rrset_ttl", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetChangeTTLRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			rrset.TTL = req.TTL
+			return nil
+		}),
+
+		"set_records": rrsetAction("set_rrset_records", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetSetRecordsRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			rrset.Records = append([]schema.ZoneRRSetRecord{}, req.Records...)
+			return nil
+		}),
+
+		"add_records": rrsetAction("add_rrset_records", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetAddRecordsRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			rrset.Records = mergeRecords(rrset.Records, req.Records)
+			if req.TTL != nil {
+				rrset.TTL = req.TTL
+			}
+			return nil
+		}),
+
+		"update_records": rrsetAction("update_rrset_records", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetUpdateRecordsRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			for _, o := range req.Records {
+				i := slices.IndexFunc(rrset.Records, func(record schema.ZoneRRSetRecord) bool { return record.Value == o.Value })
+				if i < 0 {
+					return errNotFound("record")
+				}
+				rrset.Records[i].Comment = o.Comment
+			}
+			return nil
+		}),
+
+		"remove_records": rrsetAction("remove_rrset_records", func(r *http.Request, _ *schema.Zone, rrset *schema.ZoneRRSet) error {
+			var req schema.ZoneRRSetRemoveRecordsRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			rrset.Records = slices.DeleteFunc(rrset.Records, func(record schema.ZoneRRSetRecord) bool {
+				return slices.ContainsFunc(req.Records, func(o schema.ZoneRRSetRecord) bool { return o.Value == record.Value })
+			})
+			return nil
+		}),
+	}
+
+	s.handle("POST /zones/{id}/rrsets/{name}/{type}/actions/{command}", func(r *http.Request) (int, any, error) {
+		fn, ok := commands[r.PathValue("command")]
+		if !ok {
+			return 0, nil, errNotFound("action")
+		}
+		zone, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			// Adding records to a missing RRSet creates it.
+			zone, zoneErr := s.lookupZone(r)
+			if r.PathValue("command") != "add_records" || zoneErr != nil {
+				return 0, nil, err
+			}
+			rrset = &schema.ZoneRRSet{Name: r.PathValue("name"), Type: r.PathValue("type"), Labels: map[string]string{}, Records: []schema.ZoneRRSetRecord{}}
+			s.putRRSet(zone, rrset)
+			return fn(r, zone, rrset)
+		}
+		return fn(r, zone, rrset)
+	})
+}
@@ -0,0 +1,166 @@
+package firewallutil
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// Flow is a network flow from the perspective of a server: an inbound flow is received
+// by the server, an outbound flow is sent by the server.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Flow struct {
+	Direction   hcloud.FirewallRuleDirection
+	Protocol    hcloud.FirewallRuleProtocol
+	Source      net.IP
+	Destination net.IP
+	// Port is the destination port, only used for the TCP and UDP protocols.
+	Port int
+}
+
+// Match is a firewall rule matching a flow.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Match struct {
+	Firewall *hcloud.Firewall
+	Rule     hcloud.FirewallRule
+}
+
+// Verdict is the result of the evaluation of a flow.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Verdict struct {
+	// Allowed reports whether the flow is allowed.
+	Allowed bool
+	// Matches are the rules allowing the flow.
+	Matches []Match
+	// Default reports whether the verdict is the default of the direction, because
+	// none of the firewalls define a rule for this direction.
+	Default bool
+}
+
+// Evaluate evaluates the flow against the firewalls applied to a server, like the
+// firewalls of the Hetzner Cloud do:
+//   - the rules of all the firewalls are combined, a flow is allowed if any rule
+//     matches it,
+//   - without any rule for the inbound direction, all the inbound flows are denied,
+//   - without any rule for the outbound direction, all the outbound flows are allowed,
+//   - without any firewall, all the flows are allowed.
+//
+// Responses to allowed flows are always allowed, as the firewalls are stateful.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Evaluate(firewalls []*hcloud.Firewall, flow Flow) (Verdict, error) {
+	if len(firewalls) == 0 {
+		return Verdict{Allowed: true, Default: true}, nil
+	}
+
+	verdict := Verdict{}
+	hasRules := false
+
+	for _, firewall := range firewalls {
+		for _, rule := range firewall.Rules {
+			if rule.Direction != flow.Direction {
+				continue
+			}
+			hasRules = true
+
+			matches, err := MatchRule(rule, flow)
+			if err != nil {
+				return Verdict{}, fmt.Errorf("firewall %d: %w", firewall.ID, err)
+			}
+			if matches {
+				verdict.Matches = append(verdict.Matches, Match{Firewall: firewall, Rule: rule})
+			}
+		}
+	}
+
+	if !hasRules {
+		return Verdict{Allowed: flow.Direction == hcloud.FirewallRuleDirectionOut, Default: true}, nil
+	}
+
+	verdict.Allowed = len(verdict.Matches) > 0
+	return verdict, nil
+}
+
+// MatchRule reports whether the firewall rule matches the flow.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func MatchRule(rule hcloud.FirewallRule, flow Flow) (bool, error) {
+	if err := ValidateRule(rule); err != nil {
+		return false, err
+	}
+
+	if rule.Direction != flow.Direction || rule.Protocol != flow.Protocol {
+		return false, nil
+	}
+
+	if rule.Port != nil {
+		// The port range was validated above.
+		ports, _ := ParsePortRange(*rule.Port)
+		if !ports.Contains(flow.Port) {
+			return false, nil
+		}
+	}
+
+	switch rule.Direction {
+	case hcloud.FirewallRuleDirectionIn:
+		return containsIP(rule.SourceIPs, flow.Source), nil
+	case hcloud.FirewallRuleDirectionOut:
+		return containsIP(rule.DestinationIPs, flow.Destination), nil
+	}
+	return false, nil
+}
+
+// ValidateRule checks that the firewall rule is valid:
+//   - the direction and protocol are known,
+//   - the port is set, and a valid port range, for the TCP and UDP protocols only,
+//   - the source IPs are set for inbound rules, and the destination IPs for outbound
+//     rules.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ValidateRule(rule hcloud.FirewallRule) error {
+	errs := make([]error, 0)
+
+	switch rule.Direction {
+	case hcloud.FirewallRuleDirectionIn:
+		if len(rule.SourceIPs) == 0 {
+			errs = append(errs, errors.New("missing source IPs for inbound rule"))
+		}
+	case hcloud.FirewallRuleDirectionOut:
+		if len(rule.DestinationIPs) == 0 {
+			errs = append(errs, errors.New("missing destination IPs for outbound rule"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid direction %q", rule.Direction))
+	}
+
+	switch rule.Protocol {
+	case hcloud.FirewallRuleProtocolTCP, hcloud.FirewallRuleProtocolUDP:
+		if rule.Port == nil {
+			errs = append(errs, fmt.Errorf("missing port for protocol %s", rule.Protocol))
+		} else if _, err := ParsePortRange(*rule.Port); err != nil {
+			errs = append(errs, err)
+		}
+	case hcloud.FirewallRuleProtocolICMP, hcloud.FirewallRuleProtocolESP, hcloud.FirewallRuleProtocolGRE:
+		if rule.Port != nil {
+			errs = append(errs, fmt.Errorf("port not allowed for protocol %s", rule.Protocol))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid protocol %q", rule.Protocol))
+	}
+
+	return errors.Join(errs...)
+}
+
+func containsIP(ipNets []net.IPNet, ip net.IP) bool {
+	for _, ipNet := range ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
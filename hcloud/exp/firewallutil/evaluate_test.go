@@ -0,0 +1,171 @@
+package firewallutil
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func mustParseIPNets(t *testing.T, values ...string) []net.IPNet {
+	t.Helper()
+
+	result := make([]net.IPNet, 0, len(values))
+	for _, value := range values {
+		_, ipNet, err := net.ParseCIDR(value)
+		require.NoError(t, err)
+		result = append(result, *ipNet)
+	}
+	return result
+}
+
+func TestEvaluate(t *testing.T) {
+	ssh := hcloud.FirewallRule{
+		Direction: hcloud.FirewallRuleDirectionIn,
+		Protocol:  hcloud.FirewallRuleProtocolTCP,
+		Port:      hcloud.Ptr("22"),
+		SourceIPs: mustParseIPNets(t, "10.0.0.0/8"),
+	}
+	web := hcloud.FirewallRule{
+		Direction: hcloud.FirewallRuleDirectionIn,
+		Protocol:  hcloud.FirewallRuleProtocolTCP,
+		Port:      hcloud.Ptr("80-443"),
+		SourceIPs: mustParseIPNets(t, "0.0.0.0/0", "::/0"),
+	}
+	icmp := hcloud.FirewallRule{
+		Direction: hcloud.FirewallRuleDirectionIn,
+		Protocol:  hcloud.FirewallRuleProtocolICMP,
+		SourceIPs: mustParseIPNets(t, "0.0.0.0/0"),
+	}
+	dns := hcloud.FirewallRule{
+		Direction:      hcloud.FirewallRuleDirectionOut,
+		Protocol:       hcloud.FirewallRuleProtocolUDP,
+		Port:           hcloud.Ptr("53"),
+		DestinationIPs: mustParseIPNets(t, "9.9.9.9/32"),
+	}
+
+	admin := &hcloud.Firewall{ID: 1, Rules: []hcloud.FirewallRule{ssh}}
+	public := &hcloud.Firewall{ID: 2, Rules: []hcloud.FirewallRule{web, icmp}}
+	egress := &hcloud.Firewall{ID: 3, Rules: []hcloud.FirewallRule{dns}}
+
+	inbound := func(source string, protocol hcloud.FirewallRuleProtocol, port int) Flow {
+		return Flow{Direction: hcloud.FirewallRuleDirectionIn, Protocol: protocol, Source: net.ParseIP(source), Port: port}
+	}
+	outbound := func(destination string, protocol hcloud.FirewallRuleProtocol, port int) Flow {
+		return Flow{Direction: hcloud.FirewallRuleDirectionOut, Protocol: protocol, Destination: net.ParseIP(destination), Port: port}
+	}
+
+	testCases := []struct {
+		name      string
+		firewalls []*hcloud.Firewall
+		flow      Flow
+		want      Verdict
+	}{
+		{
+			name:      "ssh from private network",
+			firewalls: []*hcloud.Firewall{admin, public},
+			flow:      inbound("10.1.2.3", hcloud.FirewallRuleProtocolTCP, 22),
+			want:      Verdict{Allowed: true, Matches: []Match{{Firewall: admin, Rule: ssh}}},
+		},
+		{
+			name:      "ssh from internet",
+			firewalls: []*hcloud.Firewall{admin, public},
+			flow:      inbound("203.0.113.1", hcloud.FirewallRuleProtocolTCP, 22),
+			want:      Verdict{Allowed: false},
+		},
+		{
+			name:      "https over ipv6",
+			firewalls: []*hcloud.Firewall{admin, public},
+			flow:      inbound("2001:db8::1", hcloud.FirewallRuleProtocolTCP, 443),
+			want:      Verdict{Allowed: true, Matches: []Match{{Firewall: public, Rule: web}}},
+		},
+		{
+			name:      "udp on web ports",
+			firewalls: []*hcloud.Firewall{admin, public},
+			flow:      inbound("203.0.113.1", hcloud.FirewallRuleProtocolUDP, 443),
+			want:      Verdict{Allowed: false},
+		},
+		{
+			name:      "icmp",
+			firewalls: []*hcloud.Firewall{public},
+			flow:      inbound("203.0.113.1", hcloud.FirewallRuleProtocolICMP, 0),
+			want:      Verdict{Allowed: true, Matches: []Match{{Firewall: public, Rule: icmp}}},
+		},
+		{
+			name:      "no inbound rules",
+			firewalls: []*hcloud.Firewall{egress},
+			flow:      inbound("10.1.2.3", hcloud.FirewallRuleProtocolTCP, 22),
+			want:      Verdict{Allowed: false, Default: true},
+		},
+		{
+			name:      "no outbound rules",
+			firewalls: []*hcloud.Firewall{admin},
+			flow:      outbound("1.1.1.1", hcloud.FirewallRuleProtocolUDP, 53),
+			want:      Verdict{Allowed: true, Default: true},
+		},
+		{
+			name:      "outbound denied",
+			firewalls: []*hcloud.Firewall{admin, egress},
+			flow:      outbound("1.1.1.1", hcloud.FirewallRuleProtocolUDP, 53),
+			want:      Verdict{Allowed: false},
+		},
+		{
+			name:      "outbound allowed",
+			firewalls: []*hcloud.Firewall{admin, egress},
+			flow:      outbound("9.9.9.9", hcloud.FirewallRuleProtocolUDP, 53),
+			want:      Verdict{Allowed: true, Matches: []Match{{Firewall: egress, Rule: dns}}},
+		},
+		{
+			name: "no firewalls",
+			flow: inbound("203.0.113.1", hcloud.FirewallRuleProtocolTCP, 22),
+			want: Verdict{Allowed: true, Default: true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			verdict, err := Evaluate(testCase.firewalls, testCase.flow)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, verdict)
+		})
+	}
+}
+
+func TestEvaluateInvalidRule(t *testing.T) {
+	firewall := &hcloud.Firewall{ID: 1, Rules: []hcloud.FirewallRule{{
+		Direction: hcloud.FirewallRuleDirectionIn,
+		Protocol:  hcloud.FirewallRuleProtocolTCP,
+		Port:      hcloud.Ptr("22-"),
+		SourceIPs: mustParseIPNets(t, "0.0.0.0/0"),
+	}}}
+
+	_, err := Evaluate([]*hcloud.Firewall{firewall}, Flow{Direction: hcloud.FirewallRuleDirectionIn})
+	require.EqualError(t, err, `firewall 1: invalid port range "22-": invalid port ""`)
+}
+
+func TestValidateRule(t *testing.T) {
+	require.NoError(t, ValidateRule(hcloud.FirewallRule{
+		Direction:      hcloud.FirewallRuleDirectionOut,
+		Protocol:       hcloud.FirewallRuleProtocolGRE,
+		DestinationIPs: mustParseIPNets(t, "0.0.0.0/0"),
+	}))
+
+	require.EqualError(t, ValidateRule(hcloud.FirewallRule{
+		Direction: hcloud.FirewallRuleDirectionIn,
+		Protocol:  hcloud.FirewallRuleProtocolUDP,
+	}), "missing source IPs for inbound rule\nmissing port for protocol udp")
+
+	require.EqualError(t, ValidateRule(hcloud.FirewallRule{
+		Direction:      hcloud.FirewallRuleDirectionOut,
+		Protocol:       hcloud.FirewallRuleProtocolICMP,
+		Port:           hcloud.Ptr("80"),
+		DestinationIPs: mustParseIPNets(t, "0.0.0.0/0"),
+	}), "port not allowed for protocol icmp")
+
+	require.EqualError(t, ValidateRule(hcloud.FirewallRule{
+		Direction: "both",
+		Protocol:  "sctp",
+	}), "invalid direction \"both\"\ninvalid protocol \"sctp\"")
+}
@@ -0,0 +1,86 @@
+package firewallutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
+)
+
+// PortRange is a range of ports, both ends included.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type PortRange struct {
+	From int
+	To   int
+}
+
+// AnyPort is the range of all the ports.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+var AnyPort = PortRange{From: minPort, To: maxPort}
+
+// ParsePortRange parses the port of a firewall rule: a single port ("80"), a range of
+// ports ("80-85") or "any".
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ParsePortRange(value string) (PortRange, error) {
+	if value == "any" {
+		return AnyPort, nil
+	}
+
+	from, to, isRange := strings.Cut(value, "-")
+	if !isRange {
+		to = from
+	}
+
+	result := PortRange{}
+	var err error
+
+	if result.From, err = parsePort(from); err != nil {
+		return PortRange{}, fmt.Errorf("invalid port range %q: %w", value, err)
+	}
+	if result.To, err = parsePort(to); err != nil {
+		return PortRange{}, fmt.Errorf("invalid port range %q: %w", value, err)
+	}
+	if result.From > result.To {
+		return PortRange{}, fmt.Errorf("invalid port range %q: start is greater than end", value)
+	}
+
+	return result, nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", value)
+	}
+	if port < minPort || port > maxPort {
+		return 0, fmt.Errorf("port %d out of range [%d-%d]", port, minPort, maxPort)
+	}
+	return port, nil
+}
+
+// Contains reports whether the port is within the range.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r PortRange) Contains(port int) bool {
+	return r.From <= port && port <= r.To
+}
+
+// String returns the port range as used in a firewall rule.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r PortRange) String() string {
+	if r == AnyPort {
+		return "any"
+	}
+	if r.From == r.To {
+		return strconv.Itoa(r.From)
+	}
+	return fmt.Sprintf("%d-%d", r.From, r.To)
+}
@@ -0,0 +1,49 @@
+package firewallutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortRange(t *testing.T) {
+	testCases := []struct {
+		value string
+		want  PortRange
+		err   string
+	}{
+		{value: "22", want: PortRange{From: 22, To: 22}},
+		{value: "80-85", want: PortRange{From: 80, To: 85}},
+		{value: "any", want: AnyPort},
+		{value: "1-65535", want: AnyPort},
+		{value: "", err: `invalid port range "": invalid port ""`},
+		{value: "ssh", err: `invalid port range "ssh": invalid port "ssh"`},
+		{value: "80-", err: `invalid port range "80-": invalid port ""`},
+		{value: "0", err: `invalid port range "0": port 0 out of range [1-65535]`},
+		{value: "80-65536", err: `invalid port range "80-65536": port 65536 out of range [1-65535]`},
+		{value: "85-80", err: `invalid port range "85-80": start is greater than end`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+			result, err := ParsePortRange(testCase.value)
+			if testCase.err != "" {
+				require.EqualError(t, err, testCase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, result)
+		})
+	}
+}
+
+func TestPortRange(t *testing.T) {
+	ports := PortRange{From: 80, To: 85}
+	assert.True(t, ports.Contains(80))
+	assert.True(t, ports.Contains(85))
+	assert.False(t, ports.Contains(86))
+	assert.Equal(t, "80-85", ports.String())
+
+	assert.Equal(t, "22", PortRange{From: 22, To: 22}.String())
+	assert.Equal(t, "any", AnyPort.String())
+}
@@ -0,0 +1,302 @@
+package healthcheckutil
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// maxResponseSize is the maximum size of the response body searched for the expected
+// response.
+const maxResponseSize = 1 << 20
+
+// defaultStatusCodes are the status codes accepted by an HTTP health check that does
+// not specify any.
+var defaultStatusCodes = []string{"2??", "3??"}
+
+// Check runs a single health check of the service against the target host, using the
+// same logic as a Load Balancer:
+//   - a TCP health check connects to the health check port,
+//   - an HTTP health check sends a GET request to the health check port and path, with
+//     the domain as Host header (and TLS server name), without following redirects.
+//     The status code must match one of the status codes, where "?" matches any digit
+//     (defaults to "2??" and "3??"), and the response body must contain the expected
+//     response, if any. TLS certificates are not verified.
+//
+// The check fails after the timeout of the health check.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Check(ctx context.Context, service hcloud.LoadBalancerService, host string) error {
+	healthCheck := service.HealthCheck
+
+	if healthCheck.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, healthCheck.Timeout)
+		defer cancel()
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(healthCheck.Port))
+
+	switch healthCheck.Protocol {
+	case hcloud.LoadBalancerServiceProtocolTCP:
+		return checkTCP(ctx, addr)
+	case hcloud.LoadBalancerServiceProtocolHTTP, hcloud.LoadBalancerServiceProtocolHTTPS:
+		return checkHTTP(ctx, healthCheck, addr)
+	}
+	return fmt.Errorf("unsupported health check protocol: %s", healthCheck.Protocol)
+}
+
+func checkTCP(ctx context.Context, addr string) error {
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func checkHTTP(ctx context.Context, healthCheck hcloud.LoadBalancerServiceHealthCheck, addr string) error {
+	settings := hcloud.LoadBalancerServiceHealthCheckHTTP{}
+	if healthCheck.HTTP != nil {
+		settings = *healthCheck.HTTP
+	}
+
+	scheme := "http"
+	if settings.TLS || healthCheck.Protocol == hcloud.LoadBalancerServiceProtocolHTTPS {
+		scheme = "https"
+	}
+
+	path := settings.Path
+	if path == "" {
+		path = "/"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+"://"+addr+path, nil)
+	if err != nil {
+		return err
+	}
+	if settings.Domain != "" {
+		req.Host = settings.Domain
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				ServerName:         settings.Domain,
+				InsecureSkipVerify: true, //nolint:gosec // Load Balancers do not verify the certificates of the targets.
+			},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	statusCodes := settings.StatusCodes
+	if len(statusCodes) == 0 {
+		statusCodes = defaultStatusCodes
+	}
+	if !matchStatusCode(statusCodes, resp.StatusCode) {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if settings.Response != "" {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(body), settings.Response) {
+			return fmt.Errorf("response does not contain: %q", settings.Response)
+		}
+	}
+
+	return nil
+}
+
+// matchStatusCode reports whether the status code matches one of the patterns, where
+// "?" matches any digit.
+func matchStatusCode(patterns []string, statusCode int) bool {
+	code := strconv.Itoa(statusCode)
+	for _, pattern := range patterns {
+		if len(pattern) != len(code) {
+			continue
+		}
+		matches := true
+		for i := range len(code) {
+			if pattern[i] != '?' && pattern[i] != code[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// Simulator runs the health checks of a Load Balancer service against targets, and
+// tracks their health status like a Load Balancer does.
+//
+// A target is unknown until it passed or failed enough consecutive checks: the number
+// of retries of the health check, at least 1. A healthy target becomes unhealthy once it
+// failed as many consecutive checks, and the other way around.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Simulator struct {
+	service hcloud.LoadBalancerService
+	targets []string
+
+	mu     sync.Mutex
+	states map[string]*targetState
+}
+
+type targetState struct {
+	status hcloud.LoadBalancerTargetHealthStatusStatus
+	// streak is the number of consecutive checks with the same outcome, and passed
+	// whether they passed.
+	streak  int
+	passed  bool
+	lastErr error
+}
+
+// NewSimulator returns a [Simulator] running the health checks of the service against
+// the target hosts.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewSimulator(service hcloud.LoadBalancerService, targets ...string) *Simulator {
+	s := &Simulator{
+		service: service,
+		targets: targets,
+		states:  make(map[string]*targetState, len(targets)),
+	}
+	for _, target := range targets {
+		s.states[target] = &targetState{status: hcloud.LoadBalancerTargetHealthStatusStatusUnknown}
+	}
+	return s
+}
+
+// CheckOnce runs a health check against all the targets concurrently, and returns the
+// resulting health status of each target.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (s *Simulator) CheckOnce(ctx context.Context) map[string]hcloud.LoadBalancerTargetHealthStatus {
+	wg := sync.WaitGroup{}
+	for _, target := range s.targets {
+		wg.Go(func() {
+			err := Check(ctx, s.service, target)
+			s.record(target, err)
+		})
+	}
+	wg.Wait()
+
+	return s.Status()
+}
+
+func (s *Simulator) record(target string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.states[target]
+	passed := err == nil
+
+	if state.streak > 0 && state.passed == passed {
+		state.streak++
+	} else {
+		state.streak = 1
+		state.passed = passed
+	}
+	state.lastErr = err
+
+	if state.streak >= max(s.service.HealthCheck.Retries, 1) {
+		if passed {
+			state.status = hcloud.LoadBalancerTargetHealthStatusStatusHealthy
+		} else {
+			state.status = hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy
+		}
+	}
+}
+
+// Status returns the current health status of each target.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (s *Simulator) Status() map[string]hcloud.LoadBalancerTargetHealthStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]hcloud.LoadBalancerTargetHealthStatus, len(s.states))
+	for target, state := range s.states {
+		result[target] = hcloud.LoadBalancerTargetHealthStatus{
+			ListenPort: s.service.ListenPort,
+			Status:     state.status,
+		}
+	}
+	return result
+}
+
+// LastError returns the error of the last health check of the target, nil if it
+// passed or did not run yet.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (s *Simulator) LastError(target string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.states[target]; ok {
+		return state.lastErr
+	}
+	return nil
+}
+
+// Run runs the health checks against all the targets at the interval of the health
+// check (defaults to 15 seconds), until the context is done, and returns the context
+// error. The onChange function is called whenever the health status of a target changes.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (s *Simulator) Run(ctx context.Context, onChange func(target string, status hcloud.LoadBalancerTargetHealthStatus)) error {
+	interval := s.service.HealthCheck.Interval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	previous := s.Status()
+	for {
+		current := s.CheckOnce(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if onChange != nil {
+			for _, target := range s.targets {
+				if current[target] != previous[target] {
+					onChange(target, current[target])
+				}
+			}
+		}
+		previous = current
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
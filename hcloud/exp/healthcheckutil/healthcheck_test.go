@@ -0,0 +1,224 @@
+package healthcheckutil
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func splitHostPort(t *testing.T, addr string) (string, int) {
+	t.Helper()
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNumber
+}
+
+func httpService(port int, http *hcloud.LoadBalancerServiceHealthCheckHTTP) hcloud.LoadBalancerService {
+	return hcloud.LoadBalancerService{
+		Protocol:   hcloud.LoadBalancerServiceProtocolHTTP,
+		ListenPort: 80,
+		HealthCheck: hcloud.LoadBalancerServiceHealthCheck{
+			Protocol: hcloud.LoadBalancerServiceProtocolHTTP,
+			Port:     port,
+			Timeout:  time.Second,
+			Retries:  2,
+			HTTP:     http,
+		},
+	}
+}
+
+func TestCheckTCP(t *testing.T) {
+	ctx := context.Background()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port := splitHostPort(t, listener.Addr().String())
+
+	service := hcloud.LoadBalancerService{
+		HealthCheck: hcloud.LoadBalancerServiceHealthCheck{
+			Protocol: hcloud.LoadBalancerServiceProtocolTCP,
+			Port:     port,
+			Timeout:  time.Second,
+		},
+	}
+
+	require.NoError(t, Check(ctx, service, host))
+
+	require.NoError(t, listener.Close())
+	require.Error(t, Check(ctx, service, host))
+}
+
+func TestCheckHTTP(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			if r.Host != "example.com" {
+				w.WriteHeader(http.StatusMisdirectedRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case "/redirect":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+
+	testCases := []struct {
+		name string
+		http *hcloud.LoadBalancerServiceHealthCheckHTTP
+		err  string
+	}{
+		{
+			name: "success",
+			http: &hcloud.LoadBalancerServiceHealthCheckHTTP{Domain: "example.com", Path: "/healthz", Response: `"ok"`, StatusCodes: []string{"200"}},
+		},
+		{
+			name: "wrong domain",
+			http: &hcloud.LoadBalancerServiceHealthCheckHTTP{Domain: "example.org", Path: "/healthz"},
+			err:  "unexpected status code: 421",
+		},
+		{
+			name: "unexpected response",
+			http: &hcloud.LoadBalancerServiceHealthCheckHTTP{Domain: "example.com", Path: "/healthz", Response: "healthy"},
+			err:  `response does not contain: "healthy"`,
+		},
+		{
+			// Redirects are not followed, and 3xx status codes pass by default
+			name: "redirect",
+			http: &hcloud.LoadBalancerServiceHealthCheckHTTP{Path: "/redirect"},
+		},
+		{
+			name: "redirect with status codes",
+			http: &hcloud.LoadBalancerServiceHealthCheckHTTP{Path: "/redirect", StatusCodes: []string{"2??"}},
+			err:  "unexpected status code: 302",
+		},
+		{
+			name: "not found",
+			err:  "unexpected status code: 404",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Check(ctx, httpService(port, testCase.http), host)
+			if testCase.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, testCase.err)
+			}
+		})
+	}
+}
+
+func TestCheckHTTPS(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	// Silence the handshake error of the plain HTTP health check
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+
+	require.NoError(t, Check(ctx, httpService(port, &hcloud.LoadBalancerServiceHealthCheckHTTP{TLS: true}), host))
+	require.Error(t, Check(ctx, httpService(port, nil), host))
+}
+
+func TestCheckTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+
+	service := httpService(port, nil)
+	service.HealthCheck.Timeout = 10 * time.Millisecond
+
+	require.ErrorIs(t, Check(ctx, service, host), context.DeadlineExceeded)
+}
+
+func TestMatchStatusCode(t *testing.T) {
+	assert.True(t, matchStatusCode([]string{"2??"}, 204))
+	assert.True(t, matchStatusCode([]string{"404", "2??"}, 404))
+	assert.False(t, matchStatusCode([]string{"2??"}, 500))
+	assert.False(t, matchStatusCode([]string{"2?"}, 200))
+}
+
+func TestSimulator(t *testing.T) {
+	ctx := context.Background()
+
+	healthy := atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+	simulator := NewSimulator(httpService(port, nil), host)
+
+	status := func(status hcloud.LoadBalancerTargetHealthStatusStatus) map[string]hcloud.LoadBalancerTargetHealthStatus {
+		return map[string]hcloud.LoadBalancerTargetHealthStatus{host: {ListenPort: 80, Status: status}}
+	}
+
+	assert.Equal(t, status(hcloud.LoadBalancerTargetHealthStatusStatusUnknown), simulator.Status())
+
+	// The status changes after as many consecutive checks as retries
+	assert.Equal(t, status(hcloud.LoadBalancerTargetHealthStatusStatusUnknown), simulator.CheckOnce(ctx))
+	require.EqualError(t, simulator.LastError(host), "unexpected status code: 503")
+	assert.Equal(t, status(hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy), simulator.CheckOnce(ctx))
+
+	healthy.Store(true)
+	assert.Equal(t, status(hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy), simulator.CheckOnce(ctx))
+	require.NoError(t, simulator.LastError(host))
+	assert.Equal(t, status(hcloud.LoadBalancerTargetHealthStatusStatusHealthy), simulator.CheckOnce(ctx))
+}
+
+func TestSimulatorRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+	service := httpService(port, nil)
+	service.HealthCheck.Interval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	changes := make([]hcloud.LoadBalancerTargetHealthStatus, 0)
+	err := NewSimulator(service, host).Run(ctx, func(target string, status hcloud.LoadBalancerTargetHealthStatus) {
+		assert.Equal(t, host, target)
+		changes = append(changes, status)
+		cancel()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []hcloud.LoadBalancerTargetHealthStatus{
+		{ListenPort: 80, Status: hcloud.LoadBalancerTargetHealthStatusStatusHealthy},
+	}, changes)
+}
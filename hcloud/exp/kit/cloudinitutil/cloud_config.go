@@ -0,0 +1,242 @@
+package cloudinitutil
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sshutil"
+)
+
+// MaxUserDataSize is the maximum size in bytes of the user data accepted when creating
+// a server.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+const MaxUserDataSize = 32 * 1024
+
+// CloudConfig is a typed cloud-config document, see
+// https://cloudinit.readthedocs.io/en/latest/reference/modules.html
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type CloudConfig struct {
+	Hostname          string      `yaml:"hostname,omitempty"`
+	Users             []User      `yaml:"users,omitempty"`
+	SSHAuthorizedKeys []string    `yaml:"ssh_authorized_keys,omitempty"`
+	WriteFiles        []WriteFile `yaml:"write_files,omitempty"`
+	PackageUpdate     bool        `yaml:"package_update,omitempty"`
+	PackageUpgrade    bool        `yaml:"package_upgrade,omitempty"`
+	Packages          []string    `yaml:"packages,omitempty"`
+	RunCmd            []Command   `yaml:"runcmd,omitempty"`
+}
+
+// User is a user created by cloud-init.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type User struct {
+	Name              string   `yaml:"name"`
+	Groups            []string `yaml:"groups,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+}
+
+// WriteFile is a file written by cloud-init.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type WriteFile struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content,omitempty"`
+	Encoding    string `yaml:"encoding,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+	Defer       bool   `yaml:"defer,omitempty"`
+}
+
+// Command is a command run by cloud-init. When Args is set, the command is executed
+// directly, otherwise the Shell command is executed by a shell.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Command struct {
+	Args  []string
+	Shell string
+}
+
+// MarshalYAML implements [yaml.Marshaler].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c Command) MarshalYAML() (any, error) {
+	if len(c.Args) > 0 {
+		return c.Args, nil
+	}
+	return c.Shell, nil
+}
+
+// AddUser adds a user to the cloud-config.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddUser(user User) *CloudConfig {
+	c.Users = append(c.Users, user)
+	return c
+}
+
+// AddSSHAuthorizedKeys adds public keys to the authorized keys of the default user.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddSSHAuthorizedKeys(keys ...string) *CloudConfig {
+	c.SSHAuthorizedKeys = append(c.SSHAuthorizedKeys, keys...)
+	return c
+}
+
+// AddFile adds a file to write.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddFile(file WriteFile) *CloudConfig {
+	c.WriteFiles = append(c.WriteFiles, file)
+	return c
+}
+
+// AddPackages adds packages to install.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddPackages(packages ...string) *CloudConfig {
+	c.Packages = append(c.Packages, packages...)
+	return c
+}
+
+// AddCommand adds a command to run, the arguments are not interpreted by a shell.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddCommand(args ...string) *CloudConfig {
+	c.RunCmd = append(c.RunCmd, Command{Args: args})
+	return c
+}
+
+// AddShellCommand adds a command to run using a shell.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) AddShellCommand(command string) *CloudConfig {
+	c.RunCmd = append(c.RunCmd, Command{Shell: command})
+	return c
+}
+
+var (
+	usernameRegexp    = regexp.MustCompile(`^[a-z_][a-z0-9_-]*\$?$`)
+	permissionsRegexp = regexp.MustCompile(`^0?[0-7]{3,4}$`)
+	fileEncodings     = []string{"", "text/plain", "b64", "base64", "gz", "gzip", "gz+b64", "gz+base64", "gzip+b64", "gzip+base64"}
+)
+
+// Validate checks the cloud-config for invalid values, all the errors found are
+// returned.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) Validate() error {
+	var errs []error
+
+	usernames := make([]string, 0, len(c.Users))
+	for i, user := range c.Users {
+		switch {
+		case user.Name == "":
+			errs = append(errs, fmt.Errorf("users[%d]: missing name", i))
+		case !usernameRegexp.MatchString(user.Name):
+			errs = append(errs, fmt.Errorf("users[%d]: invalid name: %s", i, user.Name))
+		case slices.Contains(usernames, user.Name):
+			errs = append(errs, fmt.Errorf("users[%d]: duplicate name: %s", i, user.Name))
+		}
+		usernames = append(usernames, user.Name)
+
+		errs = append(errs, validateSSHKeys(fmt.Sprintf("users[%d].ssh_authorized_keys", i), user.SSHAuthorizedKeys)...)
+	}
+
+	errs = append(errs, validateSSHKeys("ssh_authorized_keys", c.SSHAuthorizedKeys)...)
+
+	for i, file := range c.WriteFiles {
+		if !path.IsAbs(file.Path) {
+			errs = append(errs, fmt.Errorf("write_files[%d]: path must be absolute: %s", i, file.Path))
+		}
+		if !slices.Contains(fileEncodings, file.Encoding) {
+			errs = append(errs, fmt.Errorf("write_files[%d]: invalid encoding: %s", i, file.Encoding))
+		}
+		if file.Permissions != "" && !permissionsRegexp.MatchString(file.Permissions) {
+			errs = append(errs, fmt.Errorf("write_files[%d]: invalid permissions: %s", i, file.Permissions))
+		}
+	}
+
+	for i, name := range c.Packages {
+		if strings.TrimSpace(name) == "" {
+			errs = append(errs, fmt.Errorf("packages[%d]: missing name", i))
+		}
+	}
+
+	for i, command := range c.RunCmd {
+		if len(command.Args) == 0 && command.Shell == "" {
+			errs = append(errs, fmt.Errorf("runcmd[%d]: missing command", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateSSHKeys(field string, keys []string) []error {
+	var errs []error
+	for i, key := range keys {
+		if _, err := sshutil.GetPublicKeyFingerprint([]byte(key)); err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: invalid public key", field, i))
+		}
+	}
+	return errs
+}
+
+// Render validates the cloud-config, and returns it as user data (e.g. for
+// [hcloud.ServerCreateOpts.UserData]).
+//
+// An error is returned if the user data exceeds [MaxUserDataSize].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c *CloudConfig) Render() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	data, err := c.render()
+	if err != nil {
+		return "", err
+	}
+
+	if err := ValidateUserDataSize(data); err != nil {
+		return "", err
+	}
+	return data, nil
+}
+
+func (c *CloudConfig) render() (string, error) {
+	b := &strings.Builder{}
+	b.WriteString("#cloud-config\n")
+
+	encoder := yaml.NewEncoder(b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// ValidateUserDataSize returns an error if the user data exceeds [MaxUserDataSize].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ValidateUserDataSize(data string) error {
+	if len(data) > MaxUserDataSize {
+		return fmt.Errorf("user data is too large: %d bytes, maximum is %d bytes", len(data), MaxUserDataSize)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package cloudinitutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sshutil"
+)
+
+func TestCloudConfigRender(t *testing.T) {
+	_, publicKey, err := sshutil.GenerateKeyPair()
+	require.NoError(t, err)
+	key := strings.TrimSpace(string(publicKey))
+
+	lockPasswd := true
+	config := &CloudConfig{Hostname: "web-1", PackageUpdate: true}
+	config.
+		AddUser(User{Name: "deploy", Groups: []string{"sudo"}, Shell: "/bin/bash", LockPasswd: &lockPasswd, SSHAuthorizedKeys: []string{key}}).
+		AddSSHAuthorizedKeys(key).
+		AddFile(WriteFile{Path: "/etc/motd", Content: "hello\nworld\n", Permissions: "0644"}).
+		AddPackages("nginx", "curl").
+		AddCommand("systemctl", "enable", "--now", "nginx").
+		AddShellCommand("echo done > /tmp/done")
+
+	userData, err := config.Render()
+	require.NoError(t, err)
+
+	assert.Equal(t, `#cloud-config
+hostname: web-1
+users:
+  - name: deploy
+    groups:
+      - sudo
+    shell: /bin/bash
+    lock_passwd: true
+    ssh_authorized_keys:
+      - `+key+`
+ssh_authorized_keys:
+  - `+key+`
+write_files:
+  - path: /etc/motd
+    content: |
+      hello
+      world
+    permissions: "0644"
+package_update: true
+packages:
+  - nginx
+  - curl
+runcmd:
+  - - systemctl
+    - enable
+    - --now
+    - nginx
+  - echo done > /tmp/done
+`, userData)
+}
+
+func TestCloudConfigValidate(t *testing.T) {
+	config := &CloudConfig{
+		Users: []User{
+			{Name: ""},
+			{Name: "Invalid User"},
+			{Name: "deploy", SSHAuthorizedKeys: []string{"not a key"}},
+			{Name: "deploy"},
+		},
+		WriteFiles: []WriteFile{
+			{Path: "etc/motd", Encoding: "zip", Permissions: "rwx"},
+		},
+		Packages: []string{" "},
+		RunCmd:   []Command{{}},
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+
+	assert.Equal(t, []string{
+		"users[0]: missing name",
+		"users[1]: invalid name: Invalid User",
+		"users[2].ssh_authorized_keys[0]: invalid public key",
+		"users[3]: duplicate name: deploy",
+		"write_files[0]: path must be absolute: etc/motd",
+		"write_files[0]: invalid encoding: zip",
+		"write_files[0]: invalid permissions: rwx",
+		"packages[0]: missing name",
+		"runcmd[0]: missing command",
+	}, strings.Split(err.Error(), "\n"))
+
+	_, err = config.Render()
+	require.Error(t, err)
+}
+
+func TestCloudConfigRenderTooLarge(t *testing.T) {
+	config := &CloudConfig{}
+	config.AddFile(WriteFile{Path: "/tmp/large", Content: strings.Repeat("a", MaxUserDataSize)})
+
+	_, err := config.Render()
+	require.EqualError(t, err, "user data is too large: 32830 bytes, maximum is 32768 bytes")
+}
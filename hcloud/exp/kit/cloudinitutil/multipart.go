@@ -0,0 +1,101 @@
+package cloudinitutil
+
+import (
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// Content types of the user data parts supported by cloud-init, see
+// https://cloudinit.readthedocs.io/en/latest/explanation/format.html
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+const (
+	ContentTypeCloudConfig   = "text/cloud-config"
+	ContentTypeShellScript   = "text/x-shellscript"
+	ContentTypeCloudBoothook = "text/cloud-boothook"
+	ContentTypeIncludeURL    = "text/x-include-url"
+	ContentTypeJinja2        = "text/jinja2"
+)
+
+// Part is a part of a multipart user data.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Part struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+// CloudConfigPart validates and renders the cloud-config into a [Part].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func CloudConfigPart(config *CloudConfig) (Part, error) {
+	if err := config.Validate(); err != nil {
+		return Part{}, err
+	}
+	content, err := config.render()
+	if err != nil {
+		return Part{}, err
+	}
+	return Part{ContentType: ContentTypeCloudConfig, Filename: "cloud-config.yaml", Content: content}, nil
+}
+
+// ShellScriptPart returns a [Part] for a shell script, run once on the first boot.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ShellScriptPart(filename, script string) Part {
+	return Part{ContentType: ContentTypeShellScript, Filename: filename, Content: script}
+}
+
+// RenderMultipart combines the parts into a multipart MIME user data, and returns it
+// as user data (e.g. for [hcloud.ServerCreateOpts.UserData]).
+//
+// An error is returned if the user data exceeds [MaxUserDataSize].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func RenderMultipart(parts ...Part) (string, error) {
+	if len(parts) == 0 {
+		return "", fmt.Errorf("missing parts")
+	}
+
+	body := &strings.Builder{}
+	writer := multipart.NewWriter(body)
+
+	for i, part := range parts {
+		if part.ContentType == "" {
+			return "", fmt.Errorf("parts[%d]: missing content type", i)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=%q", part.ContentType, "utf-8"))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "8bit")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+		}
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(part.Content)); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Content-Type: multipart/mixed; boundary=%q\r\n", writer.Boundary())
+	b.WriteString("MIME-Version: 1.0\r\n\r\n")
+	b.WriteString(body.String())
+
+	data := b.String()
+	if err := ValidateUserDataSize(data); err != nil {
+		return "", err
+	}
+	return data, nil
+}
@@ -0,0 +1,70 @@
+package cloudinitutil
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderMultipart(t *testing.T) {
+	config := &CloudConfig{}
+	config.AddPackages("nginx")
+
+	cloudConfig, err := CloudConfigPart(config)
+	require.NoError(t, err)
+
+	userData, err := RenderMultipart(
+		cloudConfig,
+		ShellScriptPart("setup.sh", "#!/bin/sh\necho hello\n"),
+	)
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(userData))
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", msg.Header.Get("MIME-Version"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/cloud-config; charset="utf-8"`, part.Header.Get("Content-Type"))
+	assert.Equal(t, "cloud-config.yaml", part.FileName())
+	content, err := io.ReadAll(part)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\npackages:\n  - nginx\n", string(content))
+
+	part, err = reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/x-shellscript; charset="utf-8"`, part.Header.Get("Content-Type"))
+	assert.Equal(t, "setup.sh", part.FileName())
+	content, err = io.ReadAll(part)
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho hello\n", string(content))
+
+	_, err = reader.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestRenderMultipartErrors(t *testing.T) {
+	_, err := RenderMultipart()
+	require.EqualError(t, err, "missing parts")
+
+	_, err = RenderMultipart(Part{Content: "hello"})
+	require.EqualError(t, err, "parts[0]: missing content type")
+
+	_, err = RenderMultipart(ShellScriptPart("large.sh", strings.Repeat("a", MaxUserDataSize)))
+	require.ErrorContains(t, err, "user data is too large")
+
+	_, err = CloudConfigPart(&CloudConfig{RunCmd: []Command{{}}})
+	require.EqualError(t, err, "runcmd[0]: missing command")
+}
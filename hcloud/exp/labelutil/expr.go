@@ -0,0 +1,339 @@
+package labelutil
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/util"
+)
+
+// Operator is the operator of a label selector [Requirement].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Operator string
+
+const (
+	// OperatorEquals selects resources with the label set to the value: `k=v` or `k==v`.
+	OperatorEquals Operator = "="
+	// OperatorNotEquals selects resources without the label set to the value: `k!=v`.
+	OperatorNotEquals Operator = "!="
+	// OperatorIn selects resources with the label set to one of the values: `k in (v1,v2)`.
+	OperatorIn Operator = "in"
+	// OperatorNotIn selects resources without the label set to one of the values: `k notin (v1,v2)`.
+	OperatorNotIn Operator = "notin"
+	// OperatorExists selects resources with the label: `k`.
+	OperatorExists Operator = "exists"
+	// OperatorNotExists selects resources without the label: `!k`.
+	OperatorNotExists Operator = "!"
+)
+
+// Requirement is a single requirement of a label selector.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// String returns the requirement in the label selector syntax.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r Requirement) String() string {
+	switch r.Operator {
+	case OperatorEquals, OperatorNotEquals:
+		return r.Key + string(r.Operator) + r.value()
+	case OperatorIn, OperatorNotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+	case OperatorNotExists:
+		return "!" + r.Key
+	default:
+		return r.Key
+	}
+}
+
+// Matches reports whether the labels satisfy the requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case OperatorEquals:
+		return ok && value == r.value()
+	case OperatorNotEquals:
+		return !ok || value != r.value()
+	case OperatorIn:
+		return ok && slices.Contains(r.Values, value)
+	case OperatorNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	case OperatorExists:
+		return ok
+	case OperatorNotExists:
+		return !ok
+	}
+	return false
+}
+
+// Validate checks the key, the operator and the values of the requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r Requirement) Validate() error {
+	if !util.LabelKeyRegexp.MatchString(r.Key) {
+		return fmt.Errorf("invalid label key: '%s'", r.Key)
+	}
+
+	switch r.Operator {
+	case OperatorEquals, OperatorNotEquals:
+		if len(r.Values) != 1 {
+			return fmt.Errorf("operator '%s' requires a single value (key: %s)", r.Operator, r.Key)
+		}
+	case OperatorIn, OperatorNotIn:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("operator '%s' requires at least one value (key: %s)", r.Operator, r.Key)
+		}
+	case OperatorExists, OperatorNotExists:
+		if len(r.Values) != 0 {
+			return fmt.Errorf("operator '%s' does not accept values (key: %s)", r.Operator, r.Key)
+		}
+	default:
+		return fmt.Errorf("invalid operator: '%s'", r.Operator)
+	}
+
+	for _, value := range r.Values {
+		if !util.LabelValueRegexp.MatchString(value) {
+			return fmt.Errorf("invalid label value: '%s' (key: %s)", value, r.Key)
+		}
+	}
+	return nil
+}
+
+func (r Requirement) value() string {
+	if len(r.Values) == 0 {
+		return ""
+	}
+	return r.Values[0]
+}
+
+// Expr is a label selector expression, a resource is selected when all the
+// requirements match its labels.
+//
+// See https://docs.hetzner.cloud/reference/cloud#label-selector
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Expr struct {
+	Requirements []Requirement
+}
+
+// NewExpr returns an empty label selector expression, that selects all the resources.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewExpr() *Expr {
+	return &Expr{}
+}
+
+// Equals adds a `k=v` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) Equals(key, value string) *Expr {
+	return e.add(key, OperatorEquals, value)
+}
+
+// NotEquals adds a `k!=v` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) NotEquals(key, value string) *Expr {
+	return e.add(key, OperatorNotEquals, value)
+}
+
+// In adds a `k in (v1,v2)` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) In(key string, values ...string) *Expr {
+	return e.add(key, OperatorIn, values...)
+}
+
+// NotIn adds a `k notin (v1,v2)` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) NotIn(key string, values ...string) *Expr {
+	return e.add(key, OperatorNotIn, values...)
+}
+
+// Exists adds a `k` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) Exists(key string) *Expr {
+	return e.add(key, OperatorExists)
+}
+
+// NotExists adds a `!k` requirement.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) NotExists(key string) *Expr {
+	return e.add(key, OperatorNotExists)
+}
+
+func (e *Expr) add(key string, operator Operator, values ...string) *Expr {
+	e.Requirements = append(e.Requirements, Requirement{Key: key, Operator: operator, Values: values})
+	return e
+}
+
+// String returns the expression in the label selector syntax, to be used for example
+// in [hcloud.ListOpts.LabelSelector].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) String() string {
+	parts := make([]string, 0, len(e.Requirements))
+	for _, requirement := range e.Requirements {
+		parts = append(parts, requirement.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// Validate checks all the requirements of the expression.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) Validate() error {
+	errs := make([]error, 0)
+	for _, requirement := range e.Requirements {
+		if err := requirement.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Matches reports whether the labels satisfy all the requirements of the expression.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (e *Expr) Matches(labels map[string]string) bool {
+	for _, requirement := range e.Requirements {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+var setRequirementRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// Parse parses and validates a label selector.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Parse(selector string) (*Expr, error) {
+	expr := NewExpr()
+
+	terms, err := splitTerms(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, term := range terms {
+		requirement, err := parseRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		if err := requirement.Validate(); err != nil {
+			return nil, err
+		}
+		expr.Requirements = append(expr.Requirements, requirement)
+	}
+
+	return expr, nil
+}
+
+// Matches parses the label selector, and reports whether the labels satisfy it.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Matches(selector string, labels map[string]string) (bool, error) {
+	expr, err := Parse(selector)
+	if err != nil {
+		return false, err
+	}
+	return expr.Matches(labels), nil
+}
+
+// splitTerms splits the selector at the commas that are not within parentheses.
+func splitTerms(selector string) ([]string, error) {
+	var (
+		terms []string
+		depth int
+		start int
+	)
+
+	addTerm := func(term string) error {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return fmt.Errorf("invalid label selector: empty requirement in '%s'", selector)
+		}
+		terms = append(terms, term)
+		return nil
+	}
+
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("invalid label selector: nested parentheses in '%s'", selector)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid label selector: unexpected ')' in '%s'", selector)
+			}
+		case ',':
+			if depth == 0 {
+				if err := addTerm(selector[start:i]); err != nil {
+					return nil, err
+				}
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid label selector: missing ')' in '%s'", selector)
+	}
+
+	if strings.TrimSpace(selector) == "" {
+		return terms, nil
+	}
+	if err := addTerm(selector[start:]); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+func parseRequirement(term string) (Requirement, error) {
+	if matches := setRequirementRegexp.FindStringSubmatch(term); matches != nil {
+		values := make([]string, 0)
+		for _, value := range strings.Split(matches[3], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return Requirement{Key: matches[1], Operator: Operator(matches[2]), Values: values}, nil
+	}
+
+	if strings.ContainsAny(term, "()") {
+		return Requirement{}, fmt.Errorf("invalid label selector requirement: '%s'", term)
+	}
+
+	if key, ok := strings.CutPrefix(term, "!"); ok {
+		return Requirement{Key: strings.TrimSpace(key), Operator: OperatorNotExists}, nil
+	}
+	if key, value, ok := strings.Cut(term, "!="); ok {
+		return Requirement{Key: strings.TrimSpace(key), Operator: OperatorNotEquals, Values: []string{strings.TrimSpace(value)}}, nil
+	}
+	if key, value, ok := strings.Cut(term, "=="); ok {
+		return Requirement{Key: strings.TrimSpace(key), Operator: OperatorEquals, Values: []string{strings.TrimSpace(value)}}, nil
+	}
+	if key, value, ok := strings.Cut(term, "="); ok {
+		return Requirement{Key: strings.TrimSpace(key), Operator: OperatorEquals, Values: []string{strings.TrimSpace(value)}}, nil
+	}
+	return Requirement{Key: term, Operator: OperatorExists}, nil
+}
@@ -0,0 +1,130 @@
+package labelutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExprString(t *testing.T) {
+	expr := NewExpr().
+		Equals("env", "prod").
+		NotEquals("tier", "db").
+		In("role", "web", "api").
+		NotIn("zone", "a", "b").
+		Exists("managed").
+		NotExists("legacy")
+
+	assert.Equal(t, "env=prod,tier!=db,role in (web,api),zone notin (a,b),managed,!legacy", expr.String())
+	assert.NoError(t, expr.Validate())
+	assert.Empty(t, NewExpr().String())
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		selector string
+		want     []Requirement
+	}{
+		{selector: "", want: nil},
+		{selector: "env=prod", want: []Requirement{{Key: "env", Operator: OperatorEquals, Values: []string{"prod"}}}},
+		{selector: "env==prod", want: []Requirement{{Key: "env", Operator: OperatorEquals, Values: []string{"prod"}}}},
+		{selector: "env = ", want: []Requirement{{Key: "env", Operator: OperatorEquals, Values: []string{""}}}},
+		{selector: "env!=prod", want: []Requirement{{Key: "env", Operator: OperatorNotEquals, Values: []string{"prod"}}}},
+		{selector: "example.com/env", want: []Requirement{{Key: "example.com/env", Operator: OperatorExists}}},
+		{selector: "! env", want: []Requirement{{Key: "env", Operator: OperatorNotExists}}},
+		{
+			selector: "env in (prod, staging),role notin(db)",
+			want: []Requirement{
+				{Key: "env", Operator: OperatorIn, Values: []string{"prod", "staging"}},
+				{Key: "role", Operator: OperatorNotIn, Values: []string{"db"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.selector, func(t *testing.T) {
+			expr, err := Parse(testCase.selector)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, expr.Requirements)
+
+			// The formatted expression must be parsed into the same requirements
+			reparsed, err := Parse(expr.String())
+			require.NoError(t, err)
+			assert.Equal(t, expr, reparsed)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		selector string
+		err      string
+	}{
+		{selector: "env=prod,", err: "invalid label selector: empty requirement in 'env=prod,'"},
+		{selector: "env in (prod", err: "invalid label selector: missing ')' in 'env in (prod'"},
+		{selector: "env in prod)", err: "invalid label selector: unexpected ')' in 'env in prod)'"},
+		{selector: "env in ((prod))", err: "invalid label selector: nested parentheses in 'env in ((prod))'"},
+		{selector: "env in ()", err: "operator 'in' requires at least one value (key: env)"},
+		{selector: "env (prod)", err: "invalid label selector requirement: 'env (prod)'"},
+		{selector: "-env=prod", err: "invalid label key: '-env'"},
+		{selector: "env=prod!", err: "invalid label value: 'prod!' (key: env)"},
+		{selector: "env notin (prod,-)", err: "invalid label value: '-' (key: env)"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.selector, func(t *testing.T) {
+			_, err := Parse(testCase.selector)
+			require.EqualError(t, err, testCase.err)
+		})
+	}
+}
+
+func TestExprValidate(t *testing.T) {
+	expr := &Expr{Requirements: []Requirement{
+		{Key: "env", Operator: OperatorEquals},
+		{Key: "env", Operator: OperatorExists, Values: []string{"prod"}},
+		{Key: "env", Operator: "~"},
+	}}
+
+	require.EqualError(t, expr.Validate(), `operator '=' requires a single value (key: env)
+operator 'exists' does not accept values (key: env)
+invalid operator: '~'`)
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"env": "prod", "role": "web", "managed": ""}
+
+	testCases := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "env=prod", want: true},
+		{selector: "env=staging", want: false},
+		{selector: "env!=staging", want: true},
+		{selector: "tier!=db", want: true},
+		{selector: "env!=prod", want: false},
+		{selector: "role in (web,api)", want: true},
+		{selector: "tier in (db)", want: false},
+		{selector: "role notin (web)", want: false},
+		{selector: "tier notin (db)", want: true},
+		{selector: "managed", want: true},
+		{selector: "tier", want: false},
+		{selector: "!tier", want: true},
+		{selector: "!managed", want: false},
+		{selector: "env=prod,role in (api)", want: false},
+		{selector: "env=prod,role in (web),!legacy", want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.selector, func(t *testing.T) {
+			got, err := Matches(testCase.selector, labels)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+
+	_, err := Matches("-env", labels)
+	require.Error(t, err)
+}
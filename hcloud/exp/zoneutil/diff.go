@@ -0,0 +1,250 @@
+package zoneutil
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// ChangeType is the type of a [Change].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type ChangeType string
+
+const (
+	// ChangeTypeCreateRRSet creates a new RRSet, see [hcloud.ZoneClient.CreateRRSet].
+	ChangeTypeCreateRRSet ChangeType = "create_rrset"
+	// ChangeTypeDeleteRRSet deletes an RRSet, see [hcloud.ZoneClient.DeleteRRSet].
+	ChangeTypeDeleteRRSet ChangeType = "delete_rrset"
+	// ChangeTypeAddRecords adds records to an RRSet, see [hcloud.ZoneClient.AddRRSetRecords].
+	ChangeTypeAddRecords ChangeType = "add_records"
+	// ChangeTypeRemoveRecords removes records from an RRSet, see [hcloud.ZoneClient.RemoveRRSetRecords].
+	ChangeTypeRemoveRecords ChangeType = "remove_records"
+	// ChangeTypeSetRecords overwrites the records of an RRSet, see [hcloud.ZoneClient.SetRRSetRecords].
+	ChangeTypeSetRecords ChangeType = "set_records"
+	// ChangeTypeChangeTTL changes the TTL of an RRSet, see [hcloud.ZoneClient.ChangeRRSetTTL].
+	ChangeTypeChangeTTL ChangeType = "change_ttl"
+)
+
+// Change is a single API call needed to update the RRSets of a zone.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Change struct {
+	Type ChangeType
+
+	Name      string
+	RRSetType hcloud.ZoneRRSetType
+	// TTL is the TTL of the created RRSet, or the new TTL of the RRSet.
+	TTL *int
+	// Records are the records to create, add, remove or set.
+	Records []hcloud.ZoneRRSetRecord
+}
+
+// String returns a human readable description of the change.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (c Change) String() string {
+	values := make([]string, 0, len(c.Records))
+	for _, record := range c.Records {
+		values = append(values, record.Value)
+	}
+
+	ttl := "default"
+	if c.TTL != nil {
+		ttl = fmt.Sprintf("%d", *c.TTL)
+	}
+
+	switch c.Type {
+	case ChangeTypeDeleteRRSet:
+		return fmt.Sprintf("%s %s %s", c.Type, c.Name, c.RRSetType)
+	case ChangeTypeChangeTTL:
+		return fmt.Sprintf("%s %s %s %s", c.Type, c.Name, c.RRSetType, ttl)
+	default:
+		return fmt.Sprintf("%s %s %s [%s]", c.Type, c.Name, c.RRSetType, strings.Join(values, ", "))
+	}
+}
+
+// Diff returns the minimal list of changes needed to turn the current RRSets into the
+// desired RRSets. The RRSets are identified by their name and type, and their records
+// by their value.
+//
+// The RRSets are deleted before any other change, to prevent conflicts (e.g. when
+// replacing an A RRSet with a CNAME RRSet). A desired RRSet without records is
+// deleted. SOA RRSets are managed by the API and are ignored.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Diff(current, desired []*hcloud.ZoneRRSet) []Change {
+	currentByKey := indexRRSets(current)
+	desiredByKey := indexRRSets(desired)
+
+	deletions := make([]Change, 0)
+	changes := make([]Change, 0)
+
+	for _, key := range sortedKeys(currentByKey) {
+		if _, ok := desiredByKey[key]; !ok {
+			rrset := currentByKey[key]
+			deletions = append(deletions, Change{Type: ChangeTypeDeleteRRSet, Name: rrset.Name, RRSetType: rrset.Type})
+		}
+	}
+
+	for _, key := range sortedKeys(desiredByKey) {
+		want := desiredByKey[key]
+		records := uniqueRecords(want.Records)
+
+		got, ok := currentByKey[key]
+		if !ok {
+			changes = append(changes, Change{
+				Type:      ChangeTypeCreateRRSet,
+				Name:      want.Name,
+				RRSetType: want.Type,
+				TTL:       want.TTL,
+				Records:   records,
+			})
+			continue
+		}
+
+		changes = append(changes, diffRecords(got, want.Name, want.Type, records)...)
+
+		if !equalTTL(got.TTL, want.TTL) {
+			changes = append(changes, Change{Type: ChangeTypeChangeTTL, Name: want.Name, RRSetType: want.Type, TTL: want.TTL})
+		}
+	}
+
+	return append(deletions, changes...)
+}
+
+// diffRecords returns the change needed to update the records of an existing RRSet.
+// The records are set at once when records are both added and removed, or when a
+// comment changed.
+func diffRecords(current *hcloud.ZoneRRSet, name string, rrsetType hcloud.ZoneRRSetType, records []hcloud.ZoneRRSetRecord) []Change {
+	var added, removed []hcloud.ZoneRRSetRecord
+	commentChanged := false
+
+	for _, record := range records {
+		i := slices.IndexFunc(current.Records, func(o hcloud.ZoneRRSetRecord) bool { return o.Value == record.Value })
+		if i < 0 {
+			added = append(added, record)
+			continue
+		}
+		if current.Records[i].Comment != record.Comment {
+			commentChanged = true
+		}
+	}
+	for _, record := range current.Records {
+		if !slices.ContainsFunc(records, func(o hcloud.ZoneRRSetRecord) bool { return o.Value == record.Value }) {
+			removed = append(removed, record)
+		}
+	}
+
+	switch {
+	case commentChanged || (len(added) > 0 && len(removed) > 0):
+		return []Change{{Type: ChangeTypeSetRecords, Name: name, RRSetType: rrsetType, Records: records}}
+	case len(added) > 0:
+		return []Change{{Type: ChangeTypeAddRecords, Name: name, RRSetType: rrsetType, Records: added}}
+	case len(removed) > 0:
+		return []Change{{Type: ChangeTypeRemoveRecords, Name: name, RRSetType: rrsetType, Records: removed}}
+	}
+	return nil
+}
+
+// ApplyChanges applies the changes to the RRSets of the zone, and returns the
+// resulting actions.
+//
+// The changes are applied in order, waiting for the action of each change to complete
+// before applying the next one: deleted RRSets are gone before the RRSets replacing
+// them are created, and the zone is not locked by a pending action. Applying stops on
+// the first error.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ApplyChanges(ctx context.Context, zoneClient *hcloud.ZoneClient, actionClient *hcloud.ActionClient, zone *hcloud.Zone, changes []Change) ([]*hcloud.Action, error) {
+	actions := make([]*hcloud.Action, 0, len(changes))
+
+	for _, change := range changes {
+		rrset := &hcloud.ZoneRRSet{Zone: zone, Name: change.Name, Type: change.RRSetType}
+
+		var (
+			action *hcloud.Action
+			err    error
+		)
+
+		switch change.Type {
+		case ChangeTypeCreateRRSet:
+			var result hcloud.ZoneRRSetCreateResult
+			result, _, err = zoneClient.CreateRRSet(ctx, zone, hcloud.ZoneRRSetCreateOpts{
+				Name:    change.Name,
+				Type:    change.RRSetType,
+				TTL:     change.TTL,
+				Records: change.Records,
+			})
+			action = result.Action
+		case ChangeTypeDeleteRRSet:
+			var result hcloud.ZoneRRSetDeleteResult
+			result, _, err = zoneClient.DeleteRRSet(ctx, rrset)
+			action = result.Action
+		case ChangeTypeAddRecords:
+			action, _, err = zoneClient.AddRRSetRecords(ctx, rrset, hcloud.ZoneRRSetAddRecordsOpts{Records: change.Records})
+		case ChangeTypeRemoveRecords:
+			action, _, err = zoneClient.RemoveRRSetRecords(ctx, rrset, hcloud.ZoneRRSetRemoveRecordsOpts{Records: change.Records})
+		case ChangeTypeSetRecords:
+			action, _, err = zoneClient.SetRRSetRecords(ctx, rrset, hcloud.ZoneRRSetSetRecordsOpts{Records: change.Records})
+		case ChangeTypeChangeTTL:
+			action, _, err = zoneClient.ChangeRRSetTTL(ctx, rrset, hcloud.ZoneRRSetChangeTTLOpts{TTL: change.TTL})
+		default:
+			err = fmt.Errorf("unknown change type: %s", change.Type)
+		}
+		if err != nil {
+			return actions, fmt.Errorf("%s %s/%s: %w", change.Type, change.Name, change.RRSetType, err)
+		}
+		if action != nil {
+			actions = append(actions, action)
+
+			if err := actionClient.WaitFor(ctx, action); err != nil {
+				return actions, fmt.Errorf("%s %s/%s: %w", change.Type, change.Name, change.RRSetType, err)
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// indexRRSets returns the RRSets with records by name and type, ignoring the SOA RRSets.
+func indexRRSets(rrsets []*hcloud.ZoneRRSet) map[string]*hcloud.ZoneRRSet {
+	result := make(map[string]*hcloud.ZoneRRSet, len(rrsets))
+	for _, rrset := range rrsets {
+		if rrset.Type == hcloud.ZoneRRSetTypeSOA || len(rrset.Records) == 0 {
+			continue
+		}
+		result[rrset.Name+"/"+string(rrset.Type)] = rrset
+	}
+	return result
+}
+
+func sortedKeys(rrsets map[string]*hcloud.ZoneRRSet) []string {
+	keys := make([]string, 0, len(rrsets))
+	for key := range rrsets {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// uniqueRecords returns the records without duplicated values.
+func uniqueRecords(records []hcloud.ZoneRRSetRecord) []hcloud.ZoneRRSetRecord {
+	result := make([]hcloud.ZoneRRSetRecord, 0, len(records))
+	for _, record := range records {
+		if !slices.ContainsFunc(result, func(o hcloud.ZoneRRSetRecord) bool { return o.Value == record.Value }) {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+func equalTTL(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,135 @@
+package zoneutil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func records(values ...string) []hcloud.ZoneRRSetRecord {
+	result := make([]hcloud.ZoneRRSetRecord, 0, len(values))
+	for _, value := range values {
+		result = append(result, hcloud.ZoneRRSetRecord{Value: value})
+	}
+	return result
+}
+
+func TestDiff(t *testing.T) {
+	current := []*hcloud.ZoneRRSet{
+		{Name: "@", Type: "SOA", Records: records("hydrogen.ns.hetzner.com. dns.hetzner.com. 1 86400 10800 3600000 3600")},
+		{Name: "@", Type: "NS", Records: records("hydrogen.ns.hetzner.com.", "oxygen.ns.hetzner.com.")},
+		{Name: "www", Type: "A", Records: records("10.0.0.1", "10.0.0.2")},
+		{Name: "api", Type: "A", Records: records("10.0.0.1")},
+		{Name: "mail", Type: "A", Records: records("10.0.0.1", "10.0.0.2")},
+		{Name: "old", Type: "A", Records: records("10.0.0.1")},
+		{Name: "blog", Type: "A", Records: records("10.0.0.1")},
+		{Name: "ttl", Type: "A", TTL: hcloud.Ptr(300), Records: records("10.0.0.1")},
+		{Name: "comment", Type: "A", Records: records("10.0.0.1")},
+	}
+	desired := []*hcloud.ZoneRRSet{
+		{Name: "@", Type: "NS", Records: records("oxygen.ns.hetzner.com.", "hydrogen.ns.hetzner.com.")},
+		{Name: "www", Type: "A", Records: records("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.3")},
+		{Name: "api", Type: "A", Records: records("10.0.0.2")},
+		{Name: "mail", Type: "A", Records: records("10.0.0.2")},
+		{Name: "blog", Type: "CNAME", Records: records("www")},
+		{Name: "ttl", Type: "A", Records: records("10.0.0.1")},
+		{Name: "comment", Type: "A", Records: []hcloud.ZoneRRSetRecord{{Value: "10.0.0.1", Comment: "server"}}},
+		{Name: "empty", Type: "A"},
+	}
+
+	changes := Diff(current, desired)
+
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeDeleteRRSet, Name: "blog", RRSetType: "A"},
+		{Type: ChangeTypeDeleteRRSet, Name: "old", RRSetType: "A"},
+		{Type: ChangeTypeSetRecords, Name: "api", RRSetType: "A", Records: records("10.0.0.2")},
+		{Type: ChangeTypeCreateRRSet, Name: "blog", RRSetType: "CNAME", Records: records("www")},
+		{Type: ChangeTypeSetRecords, Name: "comment", RRSetType: "A", Records: []hcloud.ZoneRRSetRecord{{Value: "10.0.0.1", Comment: "server"}}},
+		{Type: ChangeTypeRemoveRecords, Name: "mail", RRSetType: "A", Records: records("10.0.0.1")},
+		{Type: ChangeTypeChangeTTL, Name: "ttl", RRSetType: "A"},
+		{Type: ChangeTypeAddRecords, Name: "www", RRSetType: "A", Records: records("10.0.0.3")},
+	}, changes)
+
+	assert.Empty(t, Diff(current, current))
+}
+
+func TestChangeString(t *testing.T) {
+	assert.Equal(t, "delete_rrset www A", Change{Type: ChangeTypeDeleteRRSet, Name: "www", RRSetType: "A"}.String())
+	assert.Equal(t, "change_ttl www A 300", Change{Type: ChangeTypeChangeTTL, Name: "www", RRSetType: "A", TTL: hcloud.Ptr(300)}.String())
+	assert.Equal(t, "change_ttl www A default", Change{Type: ChangeTypeChangeTTL, Name: "www", RRSetType: "A"}.String())
+	assert.Equal(t, "add_records www A [10.0.0.1, 10.0.0.2]", Change{Type: ChangeTypeAddRecords, Name: "www", RRSetType: "A", Records: records("10.0.0.1", "10.0.0.2")}.String())
+}
+
+func TestApplyChanges(t *testing.T) {
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "DELETE", Path: "/zones/example.com/rrsets/old/A",
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 1, "status": "running" }}`,
+		},
+		// The RRSet is only created once the deletion completed
+		{
+			Method: "GET", Path: "/actions?id=1&page=1&sort=status&sort=id",
+			Status:  200,
+			JSONRaw: `{ "actions": [{ "id": 1, "status": "success" }]}`,
+		},
+		{
+			Method: "POST", Path: "/zones/example.com/rrsets",
+			Want: func(t *testing.T, r *http.Request) {
+				var body schema.ZoneRRSetCreateRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "blog", body.Name)
+				assert.Equal(t, "CNAME", body.Type)
+				assert.Equal(t, hcloud.Ptr(600), body.TTL)
+			},
+			Status:  201,
+			JSONRaw: `{ "rrset": { "id": "blog/CNAME" }, "action": { "id": 2, "status": "success" }}`,
+		},
+		{
+			Method: "POST", Path: "/zones/example.com/rrsets/www/A/actions/add_records",
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 3, "status": "running" }}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=3&page=1&sort=status&sort=id",
+			Status:  200,
+			JSONRaw: `{ "actions": [{ "id": 3, "status": "success" }]}`,
+		},
+		{
+			Method: "POST", Path: "/zones/example.com/rrsets/www/A/actions/change_ttl",
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 4, "status": "running" }}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=4&page=1&sort=status&sort=id",
+			Status:  200,
+			JSONRaw: `{ "actions": [{ "id": 4, "status": "error", "error": { "code": "action_failed", "message": "zone is locked" }}]}`,
+		},
+	})
+
+	client := hcloud.NewClient(
+		hcloud.WithEndpoint(server.URL),
+		hcloud.WithRetryOpts(hcloud.RetryOpts{MaxRetries: 0}),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+	)
+	zone := &hcloud.Zone{Name: "example.com"}
+
+	actions, err := ApplyChanges(context.Background(), &client.Zone, &client.Action, zone, []Change{
+		{Type: ChangeTypeDeleteRRSet, Name: "old", RRSetType: "A"},
+		{Type: ChangeTypeCreateRRSet, Name: "blog", RRSetType: "CNAME", TTL: hcloud.Ptr(600), Records: records("www")},
+		{Type: ChangeTypeAddRecords, Name: "www", RRSetType: "A", Records: records("10.0.0.3")},
+		{Type: ChangeTypeChangeTTL, Name: "www", RRSetType: "A", TTL: hcloud.Ptr(300)},
+		{Type: ChangeTypeSetRecords, Name: "www", RRSetType: "A", Records: records("10.0.0.3")},
+	})
+	require.EqualError(t, err, "change_ttl www/A: zone is locked (action_failed, 4)")
+	require.Len(t, actions, 4)
+	assert.Equal(t, []int64{1, 2, 3, 4}, []int64{actions[0].ID, actions[1].ID, actions[2].ID, actions[3].ID})
+}
@@ -0,0 +1,497 @@
+package zoneutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// Zonefile represents a zone file in the RFC 1035 format.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Zonefile struct {
+	// Origin is the fully qualified name of the zone, with a trailing dot.
+	Origin string
+	// TTL is the default TTL of the zone, defined using the $TTL directive.
+	TTL *int
+	// RRSets holds the RRSets of the zone. The names of the RRSets are relative to the
+	// origin, and "@" is used for the origin itself.
+	RRSets []*hcloud.ZoneRRSet
+}
+
+// ParseZonefile parses a zone file in the RFC 1035 format.
+//
+// The origin is used until a $ORIGIN directive is found, and may be empty when the
+// zone file defines its own origin. Relative names are resolved against the current
+// origin, and the RRSet names are returned relative to the first origin. The domain
+// names in the record data (e.g. CNAME, MX, NS, SRV) are also resolved against the
+// current origin, and returned relative to the first origin. The comment
+// at the end of a record line is stored in the comment of the record.
+//
+// Records of the same RRSet are merged, the TTL of the first record is used for the
+// RRSet. The $INCLUDE and $GENERATE directives are not supported.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ParseZonefile(data string, origin string) (*Zonefile, error) {
+	p := &zonefileParser{
+		origin: fqdn(origin),
+		rrsets: map[string]*hcloud.ZoneRRSet{},
+	}
+	result := &Zonefile{Origin: p.origin}
+
+	lines, err := splitZonefileLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		if err := p.parseLine(result, line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+	}
+
+	if result.Origin == "" && len(result.RRSets) > 0 {
+		return nil, fmt.Errorf("missing origin")
+	}
+
+	return result, nil
+}
+
+type zonefileParser struct {
+	origin     string
+	lastOwner  string
+	lastTTL    *int
+	defaultTTL *int
+
+	rrsets map[string]*hcloud.ZoneRRSet
+}
+
+func (p *zonefileParser) parseLine(result *Zonefile, line zonefileLine) error {
+	tokens := line.tokens
+
+	switch strings.ToUpper(tokens[0]) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("invalid $ORIGIN directive")
+		}
+		origin, err := p.absoluteName(tokens[1])
+		if err != nil {
+			return err
+		}
+		p.origin = origin
+		if result.Origin == "" {
+			result.Origin = origin
+		}
+		return nil
+
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("invalid $TTL directive")
+		}
+		ttl, err := parseTTL(tokens[1])
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = &ttl
+		if result.TTL == nil {
+			result.TTL = &ttl
+		}
+		return nil
+
+	case "$INCLUDE", "$GENERATE":
+		return fmt.Errorf("unsupported directive: %s", tokens[0])
+	}
+
+	owner := p.lastOwner
+	if !line.continued {
+		var err error
+		owner, err = p.absoluteName(tokens[0])
+		if err != nil {
+			return err
+		}
+		tokens = tokens[1:]
+	}
+	if owner == "" {
+		return fmt.Errorf("missing owner name")
+	}
+	p.lastOwner = owner
+
+	// The TTL and class are optional, and may be in any order
+	var ttl *int
+	for len(tokens) > 0 {
+		if isClass(tokens[0]) {
+			if strings.ToUpper(tokens[0]) != "IN" {
+				return fmt.Errorf("unsupported class: %s", tokens[0])
+			}
+			tokens = tokens[1:]
+			continue
+		}
+		if ttl == nil {
+			if value, err := parseTTL(tokens[0]); err == nil {
+				ttl = &value
+				tokens = tokens[1:]
+				continue
+			}
+		}
+		break
+	}
+	if len(tokens) < 2 {
+		return fmt.Errorf("missing record type or data")
+	}
+
+	switch {
+	case ttl != nil:
+		p.lastTTL = ttl
+	case p.defaultTTL == nil:
+		// Without $TTL directive, the TTL of the previous record is used (RFC 1035)
+		ttl = p.lastTTL
+	}
+
+	name, err := relativeName(owner, result.Origin)
+	if err != nil {
+		return err
+	}
+
+	rrsetType := hcloud.ZoneRRSetType(strings.ToUpper(tokens[0]))
+	data, err := p.resolveRecordNames(result, rrsetType, tokens[1:])
+	if err != nil {
+		return err
+	}
+	value := formatRecordValue(rrsetType, data)
+
+	key := name + "/" + string(rrsetType)
+	rrset, ok := p.rrsets[key]
+	if !ok {
+		rrset = &hcloud.ZoneRRSet{Name: name, Type: rrsetType, TTL: ttl}
+		p.rrsets[key] = rrset
+		result.RRSets = append(result.RRSets, rrset)
+	}
+	rrset.Records = append(rrset.Records, hcloud.ZoneRRSetRecord{Value: value, Comment: line.comment})
+
+	return nil
+}
+
+// absoluteName returns the fully qualified name, resolving relative names against the
+// current origin.
+func (p *zonefileParser) absoluteName(name string) (string, error) {
+	switch {
+	case name == "@":
+		if p.origin == "" {
+			return "", fmt.Errorf("missing origin")
+		}
+		return p.origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name), nil
+	case p.origin == "":
+		return "", fmt.Errorf("missing origin for relative name: %s", name)
+	case p.origin == ".":
+		return strings.ToLower(name) + ".", nil
+	default:
+		return strings.ToLower(name) + "." + p.origin, nil
+	}
+}
+
+// recordNameFields holds the indexes of the domain name fields in the record data of
+// each RRSet type.
+var recordNameFields = map[hcloud.ZoneRRSetType][]int{
+	hcloud.ZoneRRSetTypeCNAME: {0},
+	hcloud.ZoneRRSetTypeNS:    {0},
+	hcloud.ZoneRRSetTypePTR:   {0},
+	"DNAME":                   {0},
+	hcloud.ZoneRRSetTypeMX:    {1},
+	hcloud.ZoneRRSetTypeSRV:   {3},
+	hcloud.ZoneRRSetTypeSOA:   {0, 1},
+	hcloud.ZoneRRSetTypeRP:    {0, 1},
+	hcloud.ZoneRRSetTypeHTTPS: {1},
+	hcloud.ZoneRRSetTypeSVCB:  {1},
+}
+
+// resolveRecordNames resolves the relative domain names of the record data against the
+// current origin. When the current origin differs from the first origin, the names are
+// written relative to the first origin, or as absolute names when outside of it.
+func (p *zonefileParser) resolveRecordNames(result *Zonefile, rrsetType hcloud.ZoneRRSetType, data []string) ([]string, error) {
+	if p.origin == result.Origin {
+		return data, nil
+	}
+
+	data = append([]string(nil), data...)
+	for _, i := range recordNameFields[rrsetType] {
+		if i >= len(data) || strings.HasSuffix(data[i], ".") {
+			continue
+		}
+		name, err := p.absoluteName(data[i])
+		if err != nil {
+			return nil, err
+		}
+		if relative, err := relativeName(name, result.Origin); err == nil {
+			data[i] = relative
+		} else {
+			data[i] = name
+		}
+	}
+	return data, nil
+}
+
+// relativeName returns the name relative to the origin, or "@" for the origin itself.
+func relativeName(name, origin string) (string, error) {
+	if origin == "" {
+		return "", fmt.Errorf("missing origin")
+	}
+	if name == origin {
+		return "@", nil
+	}
+	if origin == "." {
+		return strings.TrimSuffix(name, "."), nil
+	}
+	if relative, ok := strings.CutSuffix(name, "."+origin); ok {
+		return relative, nil
+	}
+	return "", fmt.Errorf("name is outside of the zone %s: %s", origin, name)
+}
+
+// formatRecordValue joins the record data, and quotes the strings of TXT records.
+func formatRecordValue(rrsetType hcloud.ZoneRRSetType, data []string) string {
+	if rrsetType == hcloud.ZoneRRSetTypeTXT {
+		data = append([]string(nil), data...)
+		for i, part := range data {
+			if !IsTXTRecordQuoted(part) || len(part) == 1 {
+				data[i] = `"` + strings.ReplaceAll(part, `"`, `\"`) + `"`
+			}
+		}
+	}
+	return strings.Join(data, " ")
+}
+
+func fqdn(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name) + "."
+}
+
+func isClass(token string) bool {
+	switch strings.ToUpper(token) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+// parseTTL parses a TTL in seconds, the BIND units (e.g. 1h30m) are also supported.
+func parseTTL(value string) (int, error) {
+	if value == "" || value[0] < '0' || value[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL: %s", value)
+	}
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, nil
+	}
+
+	result, current := 0, 0
+	hasDigits := false
+	for _, c := range strings.ToLower(value) {
+		if c >= '0' && c <= '9' {
+			current = current*10 + int(c-'0')
+			hasDigits = true
+			continue
+		}
+		if !hasDigits {
+			return 0, fmt.Errorf("invalid TTL: %s", value)
+		}
+		switch c {
+		case 's':
+			result += current
+		case 'm':
+			result += current * 60
+		case 'h':
+			result += current * 60 * 60
+		case 'd':
+			result += current * 60 * 60 * 24
+		case 'w':
+			result += current * 60 * 60 * 24 * 7
+		default:
+			return 0, fmt.Errorf("invalid TTL: %s", value)
+		}
+		current, hasDigits = 0, false
+	}
+	if hasDigits {
+		return 0, fmt.Errorf("invalid TTL: %s", value)
+	}
+	return result, nil
+}
+
+type zonefileLine struct {
+	number int
+	// continued is true when the line starts with a whitespace, and therefore uses the
+	// owner name of the previous record.
+	continued bool
+	// multiline is true when the line is spanning multiple lines using parentheses.
+	multiline bool
+	tokens    []string
+	comment   string
+}
+
+// splitZonefileLines splits the zone file in logical lines of tokens. Lines are joined
+// within parentheses, and the quoted strings are kept as a single token, including the
+// quotes.
+func splitZonefileLines(data string) ([]zonefileLine, error) {
+	var (
+		result   []zonefileLine
+		current  zonefileLine
+		token    strings.Builder
+		hasToken bool
+		quoted   bool
+		escaped  bool
+		parens   int
+		comment  bool
+		comments []string
+		number   = 1
+	)
+
+	endToken := func() {
+		if hasToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			hasToken = false
+		}
+	}
+	endLine := func() {
+		endToken()
+		if len(current.tokens) > 0 {
+			// The comments of a multi-line record usually describe each value, they
+			// are not relevant for the whole record.
+			if !current.multiline {
+				current.comment = strings.Join(comments, " ")
+			}
+			result = append(result, current)
+		}
+		current = zonefileLine{}
+		comments = nil
+	}
+
+	var commentText strings.Builder
+	atLineStart := true
+
+	for _, c := range data {
+		if comment {
+			if c != '\n' {
+				commentText.WriteRune(c)
+				continue
+			}
+			comment = false
+			if text := strings.TrimSpace(commentText.String()); text != "" {
+				comments = append(comments, text)
+			}
+			commentText.Reset()
+		}
+
+		if atLineStart && parens == 0 {
+			current.number = number
+			current.continued = c == ' ' || c == '\t'
+			atLineStart = false
+		}
+
+		switch {
+		case escaped:
+			token.WriteRune(c)
+			hasToken = true
+			escaped = false
+		case c == '\\':
+			token.WriteRune(c)
+			hasToken = true
+			escaped = true
+		case c == '"':
+			token.WriteRune(c)
+			hasToken = true
+			quoted = !quoted
+		case quoted:
+			if c == '\n' {
+				number++
+			}
+			token.WriteRune(c)
+		case c == ';':
+			endToken()
+			comment = true
+		case c == '(':
+			endToken()
+			parens++
+		case c == ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unexpected closing parenthesis", number)
+			}
+			parens--
+		case c == '\n':
+			endToken()
+			number++
+			if parens > 0 {
+				current.multiline = true
+			}
+			if parens == 0 {
+				endLine()
+				atLineStart = true
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			endToken()
+		default:
+			token.WriteRune(c)
+			hasToken = true
+		}
+	}
+
+	if comment {
+		if text := strings.TrimSpace(commentText.String()); text != "" {
+			comments = append(comments, text)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if parens != 0 {
+		return nil, fmt.Errorf("line %d: unterminated parenthesis", number)
+	}
+	endLine()
+
+	return result, nil
+}
+
+// FormatZonefile returns the zone file in the RFC 1035 format, with one record per
+// line.
+//
+// This function can be reversed with [ParseZonefile].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func FormatZonefile(zonefile *Zonefile) string {
+	b := &strings.Builder{}
+
+	if zonefile.Origin != "" {
+		fmt.Fprintf(b, "$ORIGIN %s\n", fqdn(zonefile.Origin))
+	}
+	if zonefile.TTL != nil {
+		fmt.Fprintf(b, "$TTL %d\n", *zonefile.TTL)
+	}
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, rrset := range zonefile.RRSets {
+		parts := []string{rrset.Name}
+		if rrset.TTL != nil {
+			parts = append(parts, strconv.Itoa(*rrset.TTL))
+		}
+		parts = append(parts, "IN", string(rrset.Type))
+		prefix := strings.Join(parts, " ")
+
+		for _, record := range rrset.Records {
+			line := prefix + " " + record.Value
+			if record.Comment != "" {
+				line += " ; " + strings.Join(strings.Fields(record.Comment), " ")
+			}
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,194 @@
+package zoneutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestParseZonefile(t *testing.T) {
+	data := `
+$ORIGIN example.com.
+$TTL 3600
+@	IN	SOA	hydrogen.ns.hetzner.com. dns.hetzner.com. (
+		2024010100 ; serial
+		86400      ; refresh
+		10800      ; retry
+		3600000    ; expire
+		3600 )     ; minimum
+
+; Name servers
+@		IN	NS	hydrogen.ns.hetzner.com.
+		IN	NS	oxygen.ns.hetzner.com.
+www	300	IN	A	201.78.10.45 ; web server
+www		IN	A	201.78.10.46
+blog.example.com.	IN 600	CNAME	www
+mail		MX	10 mx1.example.com.
+@		TXT	"v=spf1 include:_spf.example.com ~all"
+long		TXT	"hello" "world"
+plain		TXT	hello
+
+$ORIGIN sub.example.com.
+api	1h	AAAA	2001:db8::1
+`
+
+	zonefile, err := ParseZonefile(data, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zonefile.Origin)
+	assert.Equal(t, hcloud.Ptr(3600), zonefile.TTL)
+	assert.Equal(t, []*hcloud.ZoneRRSet{
+		{Name: "@", Type: "SOA", Records: []hcloud.ZoneRRSetRecord{
+			{Value: "hydrogen.ns.hetzner.com. dns.hetzner.com. 2024010100 86400 10800 3600000 3600"},
+		}},
+		{Name: "@", Type: "NS", Records: []hcloud.ZoneRRSetRecord{
+			{Value: "hydrogen.ns.hetzner.com."},
+			{Value: "oxygen.ns.hetzner.com."},
+		}},
+		{Name: "www", Type: "A", TTL: hcloud.Ptr(300), Records: []hcloud.ZoneRRSetRecord{
+			{Value: "201.78.10.45", Comment: "web server"},
+			{Value: "201.78.10.46"},
+		}},
+		{Name: "blog", Type: "CNAME", TTL: hcloud.Ptr(600), Records: []hcloud.ZoneRRSetRecord{
+			{Value: "www"},
+		}},
+		{Name: "mail", Type: "MX", Records: []hcloud.ZoneRRSetRecord{
+			{Value: "10 mx1.example.com."},
+		}},
+		{Name: "@", Type: "TXT", Records: []hcloud.ZoneRRSetRecord{
+			{Value: `"v=spf1 include:_spf.example.com ~all"`},
+		}},
+		{Name: "long", Type: "TXT", Records: []hcloud.ZoneRRSetRecord{
+			{Value: `"hello" "world"`},
+		}},
+		{Name: "plain", Type: "TXT", Records: []hcloud.ZoneRRSetRecord{
+			{Value: `"hello"`},
+		}},
+		{Name: "api.sub", Type: "AAAA", TTL: hcloud.Ptr(3600), Records: []hcloud.ZoneRRSetRecord{
+			{Value: "2001:db8::1"},
+		}},
+	}, zonefile.RRSets)
+}
+
+func TestParseZonefilePreviousTTL(t *testing.T) {
+	// Without $TTL directive, the TTL of the previous record is used
+	zonefile, err := ParseZonefile("a 300 A 10.0.0.1\nb A 10.0.0.2\n", "example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zonefile.Origin)
+	assert.Nil(t, zonefile.TTL)
+	require.Len(t, zonefile.RRSets, 2)
+	assert.Equal(t, hcloud.Ptr(300), zonefile.RRSets[1].TTL)
+}
+
+func TestParseZonefileOriginRecordData(t *testing.T) {
+	zonefile, err := ParseZonefile(`$ORIGIN example.com.
+www    IN CNAME foo
+$ORIGIN sub.example.com.
+www    IN CNAME foo
+@      IN MX    10 mail
+@      IN NS    ns1
+_sip._tcp IN SRV 10 60 5060 sip
+alias  IN CNAME @
+ext    IN CNAME www.example.org.
+$ORIGIN example.org.
+other.example.com. IN CNAME www
+`, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*hcloud.ZoneRRSet{
+		{Name: "www", Type: "CNAME", Records: []hcloud.ZoneRRSetRecord{{Value: "foo"}}},
+		{Name: "www.sub", Type: "CNAME", Records: []hcloud.ZoneRRSetRecord{{Value: "foo.sub"}}},
+		{Name: "sub", Type: "MX", Records: []hcloud.ZoneRRSetRecord{{Value: "10 mail.sub"}}},
+		{Name: "sub", Type: "NS", Records: []hcloud.ZoneRRSetRecord{{Value: "ns1.sub"}}},
+		{Name: "_sip._tcp.sub", Type: "SRV", Records: []hcloud.ZoneRRSetRecord{{Value: "10 60 5060 sip.sub"}}},
+		{Name: "alias.sub", Type: "CNAME", Records: []hcloud.ZoneRRSetRecord{{Value: "sub"}}},
+		{Name: "ext.sub", Type: "CNAME", Records: []hcloud.ZoneRRSetRecord{{Value: "www.example.org."}}},
+		{Name: "other", Type: "CNAME", Records: []hcloud.ZoneRRSetRecord{{Value: "www.example.org."}}},
+	}, zonefile.RRSets)
+}
+
+func TestParseZonefileErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   string
+		origin string
+		err    string
+	}{
+		{name: "missing origin", data: "www A 10.0.0.1", err: "line 1: missing origin for relative name: www"},
+		{name: "outside of zone", data: "www.example.org. A 10.0.0.1", origin: "example.com", err: "line 1: name is outside of the zone example.com.: www.example.org."},
+		{name: "missing data", data: "www 300 IN A", origin: "example.com", err: "line 1: missing record type or data"},
+		{name: "unsupported class", data: "www CH A 10.0.0.1", origin: "example.com", err: "line 1: unsupported class: CH"},
+		{name: "invalid ttl", data: "$TTL 1x", origin: "example.com", err: "line 1: invalid TTL: 1x"},
+		{name: "include", data: "\n$INCLUDE other.zone", origin: "example.com", err: "line 2: unsupported directive: $INCLUDE"},
+		{name: "unterminated quote", data: `www TXT "hello`, origin: "example.com", err: "line 1: unterminated quoted string"},
+		{name: "unterminated parenthesis", data: "@ SOA a. b. ( 1 2", origin: "example.com", err: "line 1: unterminated parenthesis"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseZonefile(testCase.data, testCase.origin)
+			require.EqualError(t, err, testCase.err)
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	for value, want := range map[string]int{
+		"0":     0,
+		"3600":  3600,
+		"90s":   90,
+		"1h30m": 5400,
+		"1d":    86400,
+		"1W":    604800,
+	} {
+		got, err := parseTTL(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, got, value)
+	}
+
+	for _, value := range []string{"", "h", "1h30", "1y"} {
+		_, err := parseTTL(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestFormatZonefile(t *testing.T) {
+	zonefile := &Zonefile{
+		Origin: "example.com",
+		TTL:    hcloud.Ptr(3600),
+		RRSets: []*hcloud.ZoneRRSet{
+			{Name: "@", Type: "NS", Records: []hcloud.ZoneRRSetRecord{
+				{Value: "hydrogen.ns.hetzner.com."},
+				{Value: "oxygen.ns.hetzner.com."},
+			}},
+			{Name: "www", Type: "A", TTL: hcloud.Ptr(300), Records: []hcloud.ZoneRRSetRecord{
+				{Value: "201.78.10.45", Comment: "web\nserver"},
+			}},
+			{Name: "long", Type: "TXT", Records: []hcloud.ZoneRRSetRecord{
+				{Value: FormatTXTRecord(manyA + fewB)},
+			}},
+		},
+	}
+
+	data := FormatZonefile(zonefile)
+	assert.Equal(t, `$ORIGIN example.com.
+$TTL 3600
+
+@ IN NS hydrogen.ns.hetzner.com.
+@ IN NS oxygen.ns.hetzner.com.
+www 300 IN A 201.78.10.45 ; web server
+long IN TXT "`+manyA+`" "`+fewB+`"
+`, data)
+
+	parsed, err := ParseZonefile(data, "")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com.", parsed.Origin)
+	assert.Equal(t, zonefile.TTL, parsed.TTL)
+	assert.Equal(t, zonefile.RRSets[0], parsed.RRSets[0])
+	assert.Equal(t, "web server", parsed.RRSets[1].Records[0].Comment)
+	assert.Equal(t, manyA+fewB, ParseTXTRecord(parsed.RRSets[2].Records[0].Value))
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -185,6 +186,19 @@ func (c *FirewallClient) AllWithOpts(ctx context.Context, opts FirewallListOpts)
 	})
 }
 
+// Iter returns an iterator over all Firewalls for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *FirewallClient) Iter(ctx context.Context, opts FirewallListOpts) iter.Seq2[*Firewall, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Firewall, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // FirewallCreateOpts specifies options for creating a new Firewall.
 type FirewallCreateOpts struct {
 	Name    string
@@ -211,6 +225,7 @@ type FirewallCreateResult struct {
 func (c *FirewallClient) Create(ctx context.Context, opts FirewallCreateOpts) (FirewallCreateResult, *Response, error) {
 	const opPath = "/firewalls"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := FirewallCreateResult{}
 
@@ -0,0 +1,300 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// FirewallReconcileOpts specifies the desired state of a [Firewall].
+//
+// Each field left nil is not managed, and the matching part of the firewall is left
+// untouched. Use an empty slice to remove all rules or resources.
+type FirewallReconcileOpts struct {
+	// Rules is the desired set of rules.
+	Rules []FirewallRule
+	// Resources is the desired set of resources the firewall is applied to.
+	Resources []FirewallResource
+	// IgnoreRule reports whether a live rule is not managed by the caller. Ignored
+	// rules are kept as is.
+	IgnoreRule func(rule FirewallRule) bool
+}
+
+// FirewallRuleChange describes a rule that is changed.
+type FirewallRuleChange struct {
+	From FirewallRule
+	To   FirewallRule
+}
+
+// FirewallDiff describes the changes needed for a [Firewall] to match its desired
+// state.
+//
+// Rules are identified by their direction, protocol and port, rules with the same
+// identity but different IPs or description are changed.
+type FirewallDiff struct {
+	AddedRules      []FirewallRule
+	RemovedRules    []FirewallRule
+	ChangedRules    []FirewallRuleChange
+	ApplyResources  []FirewallResource
+	RemoveResources []FirewallResource
+
+	// rules is the complete rule set to send to the API.
+	rules []FirewallRule
+}
+
+// HasRuleChanges reports whether the rules of the firewall must be changed.
+func (d FirewallDiff) HasRuleChanges() bool {
+	return len(d.AddedRules) > 0 || len(d.RemovedRules) > 0 || len(d.ChangedRules) > 0
+}
+
+// IsEmpty reports whether the firewall already matches its desired state.
+func (d FirewallDiff) IsEmpty() bool {
+	return !d.HasRuleChanges() && len(d.ApplyResources) == 0 && len(d.RemoveResources) == 0
+}
+
+// String returns a human readable plan of the changes, one change per line.
+func (d FirewallDiff) String() string {
+	lines := make([]string, 0)
+	for _, rule := range d.AddedRules {
+		lines = append(lines, "+ rule "+formatFirewallRule(rule))
+	}
+	for _, change := range d.ChangedRules {
+		lines = append(lines, "~ rule "+formatFirewallRule(change.From)+" => "+formatFirewallRule(change.To))
+	}
+	for _, rule := range d.RemovedRules {
+		lines = append(lines, "- rule "+formatFirewallRule(rule))
+	}
+	for _, resource := range d.ApplyResources {
+		lines = append(lines, "+ resource "+formatFirewallResource(resource))
+	}
+	for _, resource := range d.RemoveResources {
+		lines = append(lines, "- resource "+formatFirewallResource(resource))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffFirewall computes the changes needed for the firewall to match the desired
+// state.
+func DiffFirewall(firewall *Firewall, opts FirewallReconcileOpts) FirewallDiff {
+	diff := FirewallDiff{}
+
+	if opts.Rules != nil {
+		live := make([]FirewallRule, 0, len(firewall.Rules))
+		for _, rule := range firewall.Rules {
+			if opts.IgnoreRule != nil && opts.IgnoreRule(rule) {
+				diff.rules = append(diff.rules, rule)
+				continue
+			}
+			live = append(live, rule)
+		}
+
+		// First match the identical rules, so that a changed rule is not matched with an
+		// identical rule further in the list.
+		desired := slices.Clone(opts.Rules)
+		matched := make([]bool, len(live))
+		pending := make([]FirewallRule, 0, len(desired))
+		for _, rule := range desired {
+			if i := indexUnmatched(live, matched, rule, equalFirewallRule); i >= 0 {
+				matched[i] = true
+				continue
+			}
+			pending = append(pending, rule)
+		}
+
+		for _, rule := range pending {
+			if i := indexUnmatched(live, matched, rule, sameFirewallRule); i >= 0 {
+				matched[i] = true
+				diff.ChangedRules = append(diff.ChangedRules, FirewallRuleChange{From: live[i], To: rule})
+				continue
+			}
+			diff.AddedRules = append(diff.AddedRules, rule)
+		}
+
+		for i, rule := range live {
+			if !matched[i] {
+				diff.RemovedRules = append(diff.RemovedRules, rule)
+			}
+		}
+
+		diff.rules = append(desired, diff.rules...)
+	}
+
+	if opts.Resources != nil {
+		for _, resource := range opts.Resources {
+			if !slices.ContainsFunc(firewall.AppliedTo, func(o FirewallResource) bool { return sameFirewallResource(o, resource) }) {
+				diff.ApplyResources = append(diff.ApplyResources, resource)
+			}
+		}
+		for _, resource := range firewall.AppliedTo {
+			if !slices.ContainsFunc(opts.Resources, func(o FirewallResource) bool { return sameFirewallResource(o, resource) }) {
+				diff.RemoveResources = append(diff.RemoveResources, resource)
+			}
+		}
+	}
+
+	return diff
+}
+
+// FirewallReconcileResult is the result of [FirewallClient.Reconcile].
+type FirewallReconcileResult struct {
+	Diff    FirewallDiff
+	Actions []*Action
+}
+
+// Plan fetches the firewall and computes the changes needed to match the desired
+// state, without applying them.
+func (c *FirewallClient) Plan(ctx context.Context, firewall *Firewall, opts FirewallReconcileOpts) (FirewallDiff, *Response, error) {
+	live, resp, err := c.GetByID(ctx, firewall.ID)
+	if err != nil {
+		return FirewallDiff{}, resp, err
+	}
+	if live == nil {
+		return FirewallDiff{}, resp, fmt.Errorf("firewall not found: %d", firewall.ID)
+	}
+
+	return DiffFirewall(live, opts), resp, nil
+}
+
+// Reconcile fetches the firewall, computes the changes needed to match the desired
+// state and applies them. The actions of each step are awaited before starting the next
+// step, as the firewall is locked while they are running.
+//
+// Use [FirewallClient.Plan] to preview the changes.
+func (c *FirewallClient) Reconcile(ctx context.Context, firewall *Firewall, opts FirewallReconcileOpts) (FirewallReconcileResult, *Response, error) {
+	result := FirewallReconcileResult{}
+
+	diff, resp, err := c.Plan(ctx, firewall, opts)
+	if err != nil {
+		return result, resp, err
+	}
+	result.Diff = diff
+
+	if diff.HasRuleChanges() {
+		var actions []*Action
+		actions, resp, err = c.SetRules(ctx, firewall, FirewallSetRulesOpts{Rules: diff.rules})
+		result.Actions = append(result.Actions, actions...)
+		if err != nil {
+			return result, resp, err
+		}
+		// The firewall is locked until the rules are set
+		if err := c.client.Action.WaitFor(ctx, actions...); err != nil {
+			return result, resp, err
+		}
+	}
+
+	if len(diff.ApplyResources) > 0 {
+		var actions []*Action
+		actions, resp, err = c.ApplyResources(ctx, firewall, diff.ApplyResources)
+		result.Actions = append(result.Actions, actions...)
+		if err != nil {
+			return result, resp, err
+		}
+		if err := c.client.Action.WaitFor(ctx, actions...); err != nil {
+			return result, resp, err
+		}
+	}
+
+	if len(diff.RemoveResources) > 0 {
+		var actions []*Action
+		actions, resp, err = c.RemoveResources(ctx, firewall, diff.RemoveResources)
+		result.Actions = append(result.Actions, actions...)
+		if err != nil {
+			return result, resp, err
+		}
+		if err := c.client.Action.WaitFor(ctx, actions...); err != nil {
+			return result, resp, err
+		}
+	}
+
+	return result, resp, nil
+}
+
+// indexUnmatched returns the index of the first rule that is not matched yet, and that
+// satisfies the compare function, or -1.
+func indexUnmatched(rules []FirewallRule, matched []bool, rule FirewallRule, compare func(a, b FirewallRule) bool) int {
+	for i, o := range rules {
+		if !matched[i] && compare(o, rule) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameFirewallRule reports whether both rules have the same identity.
+func sameFirewallRule(a, b FirewallRule) bool {
+	return a.Direction == b.Direction &&
+		a.Protocol == b.Protocol &&
+		derefString(a.Port) == derefString(b.Port)
+}
+
+// equalFirewallRule reports whether both rules are identical, the order of the IPs is
+// not relevant.
+func equalFirewallRule(a, b FirewallRule) bool {
+	return sameFirewallRule(a, b) &&
+		derefString(a.Description) == derefString(b.Description) &&
+		slices.Equal(sortedIPNets(a.SourceIPs), sortedIPNets(b.SourceIPs)) &&
+		slices.Equal(sortedIPNets(a.DestinationIPs), sortedIPNets(b.DestinationIPs))
+}
+
+// sameFirewallResource reports whether both resources target the same resource.
+func sameFirewallResource(a, b FirewallResource) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	switch a.Type {
+	case FirewallResourceTypeServer:
+		return a.Server != nil && b.Server != nil && a.Server.ID == b.Server.ID
+	case FirewallResourceTypeLabelSelector:
+		return a.LabelSelector != nil && b.LabelSelector != nil && a.LabelSelector.Selector == b.LabelSelector.Selector
+	}
+	return false
+}
+
+func sortedIPNets(ipNets []net.IPNet) []string {
+	result := make([]string, 0, len(ipNets))
+	for _, ipNet := range ipNets {
+		result = append(result, ipNet.String())
+	}
+	slices.Sort(result)
+	return result
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatFirewallRule(rule FirewallRule) string {
+	parts := []string{string(rule.Direction), string(rule.Protocol)}
+	if rule.Port != nil {
+		parts = append(parts, *rule.Port)
+	}
+	switch rule.Direction {
+	case FirewallRuleDirectionIn:
+		parts = append(parts, "from", strings.Join(sortedIPNets(rule.SourceIPs), ","))
+	case FirewallRuleDirectionOut:
+		parts = append(parts, "to", strings.Join(sortedIPNets(rule.DestinationIPs), ","))
+	}
+	if rule.Description != nil && *rule.Description != "" {
+		parts = append(parts, fmt.Sprintf("(%s)", *rule.Description))
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatFirewallResource(resource FirewallResource) string {
+	switch resource.Type {
+	case FirewallResourceTypeServer:
+		if resource.Server != nil {
+			return fmt.Sprintf("server %d", resource.Server.ID)
+		}
+	case FirewallResourceTypeLabelSelector:
+		if resource.LabelSelector != nil {
+			return fmt.Sprintf("label_selector %s", resource.LabelSelector.Selector)
+		}
+	}
+	return string(resource.Type)
+}
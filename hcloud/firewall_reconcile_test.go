@@ -0,0 +1,220 @@
+package hcloud
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func mustParseIPNets(t *testing.T, values ...string) []net.IPNet {
+	t.Helper()
+
+	result := make([]net.IPNet, 0, len(values))
+	for _, value := range values {
+		_, ipNet, err := net.ParseCIDR(value)
+		require.NoError(t, err)
+		result = append(result, *ipNet)
+	}
+	return result
+}
+
+func TestDiffFirewall(t *testing.T) {
+	ssh := FirewallRule{Direction: FirewallRuleDirectionIn, Protocol: FirewallRuleProtocolTCP, Port: Ptr("22"), SourceIPs: mustParseIPNets(t, "10.0.0.0/8")}
+	sshAny := FirewallRule{Direction: FirewallRuleDirectionIn, Protocol: FirewallRuleProtocolTCP, Port: Ptr("22"), SourceIPs: mustParseIPNets(t, "::/0", "0.0.0.0/0")}
+	http := FirewallRule{Direction: FirewallRuleDirectionIn, Protocol: FirewallRuleProtocolTCP, Port: Ptr("80"), SourceIPs: mustParseIPNets(t, "0.0.0.0/0", "::/0")}
+	icmp := FirewallRule{Direction: FirewallRuleDirectionIn, Protocol: FirewallRuleProtocolICMP, SourceIPs: mustParseIPNets(t, "0.0.0.0/0")}
+	dns := FirewallRule{Direction: FirewallRuleDirectionOut, Protocol: FirewallRuleProtocolUDP, Port: Ptr("53"), DestinationIPs: mustParseIPNets(t, "0.0.0.0/0"), Description: Ptr("dns")}
+
+	server := FirewallResource{Type: FirewallResourceTypeServer, Server: &FirewallResourceServer{ID: 42}}
+	selector := FirewallResource{Type: FirewallResourceTypeLabelSelector, LabelSelector: &FirewallResourceLabelSelector{Selector: "env=prod"}}
+
+	firewall := &Firewall{
+		ID:        1,
+		Rules:     []FirewallRule{sshAny, http, icmp, dns},
+		AppliedTo: []FirewallResource{server},
+	}
+
+	t.Run("changes", func(t *testing.T) {
+		diff := DiffFirewall(firewall, FirewallReconcileOpts{
+			Rules:     []FirewallRule{ssh, http, icmp},
+			Resources: []FirewallResource{selector},
+		})
+
+		assert.Empty(t, diff.AddedRules)
+		assert.Equal(t, []FirewallRuleChange{{From: sshAny, To: ssh}}, diff.ChangedRules)
+		assert.Equal(t, []FirewallRule{dns}, diff.RemovedRules)
+		assert.Equal(t, []FirewallResource{selector}, diff.ApplyResources)
+		assert.Equal(t, []FirewallResource{server}, diff.RemoveResources)
+		assert.Equal(t, []FirewallRule{ssh, http, icmp}, diff.rules)
+		assert.False(t, diff.IsEmpty())
+
+		assert.Equal(t, `~ rule in tcp 22 from 0.0.0.0/0,::/0 => in tcp 22 from 10.0.0.0/8
+- rule out udp 53 to 0.0.0.0/0 (dns)
++ resource label_selector env=prod
+- resource server 42`, diff.String())
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		// Order of the rules and IPs is not relevant
+		http := http
+		http.SourceIPs = mustParseIPNets(t, "::/0", "0.0.0.0/0")
+
+		diff := DiffFirewall(firewall, FirewallReconcileOpts{
+			Rules:     []FirewallRule{dns, icmp, http, sshAny},
+			Resources: []FirewallResource{server},
+		})
+		assert.True(t, diff.IsEmpty())
+		assert.Empty(t, diff.String())
+	})
+
+	t.Run("ignored rules and unmanaged resources", func(t *testing.T) {
+		diff := DiffFirewall(firewall, FirewallReconcileOpts{
+			Rules: []FirewallRule{http, ssh},
+			IgnoreRule: func(rule FirewallRule) bool {
+				return rule.Direction == FirewallRuleDirectionOut
+			},
+		})
+
+		assert.Equal(t, []FirewallRuleChange{{From: sshAny, To: ssh}}, diff.ChangedRules)
+		assert.Equal(t, []FirewallRule{icmp}, diff.RemovedRules)
+		assert.Empty(t, diff.ApplyResources)
+		assert.Empty(t, diff.RemoveResources)
+		assert.Equal(t, []FirewallRule{http, ssh, dns}, diff.rules)
+	})
+
+	t.Run("unmanaged rules", func(t *testing.T) {
+		diff := DiffFirewall(firewall, FirewallReconcileOpts{
+			Resources: []FirewallResource{server, selector},
+		})
+
+		assert.False(t, diff.HasRuleChanges())
+		assert.Equal(t, []FirewallResource{selector}, diff.ApplyResources)
+		assert.Empty(t, diff.RemoveResources)
+	})
+
+	t.Run("remove all", func(t *testing.T) {
+		diff := DiffFirewall(firewall, FirewallReconcileOpts{
+			Rules:     []FirewallRule{},
+			Resources: []FirewallResource{},
+		})
+
+		assert.Equal(t, []FirewallRule{sshAny, http, icmp, dns}, diff.RemovedRules)
+		assert.Empty(t, diff.rules)
+		assert.Equal(t, []FirewallResource{server}, diff.RemoveResources)
+	})
+}
+
+func TestFirewallClientReconcile(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/firewalls/1",
+			Status: 200,
+			JSONRaw: `{
+				"firewall": {
+					"id": 1,
+					"rules": [
+						{ "direction": "in", "protocol": "icmp", "source_ips": ["0.0.0.0/0"] }
+					],
+					"applied_to": [
+						{ "type": "server", "server": { "id": 42 }}
+					]
+				}
+			}`,
+		},
+		{
+			Method: "POST", Path: "/firewalls/1/actions/set_rules",
+			Want: func(t *testing.T, r *http.Request) {
+				var body schema.FirewallActionSetRulesRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Len(t, body.Rules, 1)
+				assert.Equal(t, "tcp", body.Rules[0].Protocol)
+			},
+			Status: 201,
+			JSONRaw: `{
+				"actions": [{ "id": 10, "status": "running" }]
+			}`,
+		},
+		// The firewall is locked until the rules are set
+		{
+			Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 10, "status": "running" }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 10, "status": "success" }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+		{
+			Method: "POST", Path: "/firewalls/1/actions/remove_from_resources",
+			Status: 201,
+			JSONRaw: `{
+				"actions": [{ "id": 11, "status": "running" }]
+			}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=11&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 11, "status": "success" }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+	})
+
+	result, _, err := client.Firewall.Reconcile(ctx, &Firewall{ID: 1}, FirewallReconcileOpts{
+		Rules: []FirewallRule{{
+			Direction: FirewallRuleDirectionIn,
+			Protocol:  FirewallRuleProtocolTCP,
+			Port:      Ptr("443"),
+			SourceIPs: mustParseIPNets(t, "0.0.0.0/0"),
+		}},
+		Resources: []FirewallResource{},
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Diff.AddedRules, 1)
+	assert.Len(t, result.Diff.RemovedRules, 1)
+	assert.Len(t, result.Diff.RemoveResources, 1)
+	assert.Len(t, result.Actions, 2)
+}
+
+func TestFirewallClientPlan(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/firewalls/1",
+			Status:  200,
+			JSONRaw: `{ "firewall": { "id": 1, "rules": [], "applied_to": [] }}`,
+		},
+		{
+			Method: "GET", Path: "/firewalls/2",
+			Status:  404,
+			JSONRaw: `{ "error": { "code": "not_found", "message": "firewall not found" }}`,
+		},
+	})
+
+	diff, _, err := client.Firewall.Plan(ctx, &Firewall{ID: 1}, FirewallReconcileOpts{
+		Resources: []FirewallResource{{Type: FirewallResourceTypeServer, Server: &FirewallResourceServer{ID: 42}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "+ resource server 42", diff.String())
+
+	_, _, err = client.Firewall.Plan(ctx, &Firewall{ID: 2}, FirewallReconcileOpts{})
+	require.EqualError(t, err, "firewall not found: 2")
+}
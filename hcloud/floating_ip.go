@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -179,6 +180,19 @@ func (c *FloatingIPClient) AllWithOpts(ctx context.Context, opts FloatingIPListO
 	})
 }
 
+// Iter returns an iterator over all Floating IPs for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *FloatingIPClient) Iter(ctx context.Context, opts FloatingIPListOpts) iter.Seq2[*FloatingIP, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*FloatingIP, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // FloatingIPCreateOpts specifies options for creating a Floating IP.
 type FloatingIPCreateOpts struct {
 	Type         FloatingIPType
@@ -216,6 +230,11 @@ func (c *FloatingIPClient) Create(ctx context.Context, opts FloatingIPCreateOpts
 	const opPath = "/floating_ips"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
+	// The name of a Floating IP is optional, unnamed Floating IPs cannot be reconciled.
+	if opts.Name != nil {
+		ctx = reconcileByName(ctx, *opts.Name, c.GetByName)
+	}
+
 	reqPath := opPath
 
 	if err := opts.Validate(); err != nil {
@@ -18,14 +18,14 @@ tool github.com/vburenin/ifacemaker -f iso.go -s ISOClient -i IISOClient -p hclo
 tool github.com/vburenin/ifacemaker -f location.go -s LocationClient -i ILocationClient -p hcloud -o zz_location_client_iface.go
 tool github.com/vburenin/ifacemaker -f network.go -s NetworkClient -i INetworkClient -p hcloud -o zz_network_client_iface.go
 tool github.com/vburenin/ifacemaker -f pricing.go -s PricingClient -i IPricingClient -p hcloud -o zz_pricing_client_iface.go
-tool github.com/vburenin/ifacemaker -f server.go -s ServerClient -i IServerClient -p hcloud -o zz_server_client_iface.go
+tool github.com/vburenin/ifacemaker -f server.go -f server_create_wait.go -s ServerClient -i IServerClient -p hcloud -o zz_server_client_iface.go
 tool github.com/vburenin/ifacemaker -f server_type.go -s ServerTypeClient -i IServerTypeClient -p hcloud -o zz_server_type_client_iface.go
 tool github.com/vburenin/ifacemaker -f ssh_key.go -s SSHKeyClient -i ISSHKeyClient -p hcloud -o zz_ssh_key_client_iface.go
 tool github.com/vburenin/ifacemaker -f volume.go -s VolumeClient -i IVolumeClient -p hcloud -o zz_volume_client_iface.go
-tool github.com/vburenin/ifacemaker -f load_balancer.go -s LoadBalancerClient -i ILoadBalancerClient -p hcloud -o zz_load_balancer_client_iface.go
+tool github.com/vburenin/ifacemaker -f load_balancer.go -f load_balancer_reconcile.go -s LoadBalancerClient -i ILoadBalancerClient -p hcloud -o zz_load_balancer_client_iface.go
 tool github.com/vburenin/ifacemaker -f load_balancer_type.go -s LoadBalancerTypeClient -i ILoadBalancerTypeClient -p hcloud -o zz_load_balancer_type_client_iface.go
 tool github.com/vburenin/ifacemaker -f certificate.go -s CertificateClient -i ICertificateClient -p hcloud -o zz_certificate_client_iface.go
-tool github.com/vburenin/ifacemaker -f firewall.go -s FirewallClient -i IFirewallClient -p hcloud -o zz_firewall_client_iface.go
+tool github.com/vburenin/ifacemaker -f firewall.go -f firewall_reconcile.go -s FirewallClient -i IFirewallClient -p hcloud -o zz_firewall_client_iface.go
 tool github.com/vburenin/ifacemaker -f placement_group.go -s PlacementGroupClient -i IPlacementGroupClient -p hcloud -o zz_placement_group_client_iface.go
 tool github.com/vburenin/ifacemaker -f rdns.go -s RDNSClient -i IRDNSClient -p hcloud -o zz_rdns_client_iface.go
 tool github.com/vburenin/ifacemaker -f primary_ip.go -s PrimaryIPClient -i IPrimaryIPClient -p hcloud -o zz_primary_ip_client_iface.go
@@ -33,5 +33,29 @@ tool github.com/vburenin/ifacemaker -f zone.go -f zone_rrset.go -s ZoneClient -i
 tool github.com/vburenin/ifacemaker -f storage_box_type.go -s StorageBoxTypeClient -i IStorageBoxTypeClient -p hcloud -o zz_storage_box_type_client_iface.go
 tool github.com/vburenin/ifacemaker -f storage_box.go -f storage_box_snapshot.go -f storage_box_subaccount.go -s StorageBoxClient -i IStorageBoxClient -p hcloud -o zz_storage_box_client_iface.go
 
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IActionClient -t mock.tmpl -o hcloudtest/zz_action_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IResourceActionClient -t mock.tmpl -v TypeParams='[R any]' -v TypeArgs='[*_sourceHcloud.Server]' -o hcloudtest/zz_resource_action_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IDatacenterClient -t mock.tmpl -o hcloudtest/zz_datacenter_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IFloatingIPClient -t mock.tmpl -o hcloudtest/zz_floating_ip_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IImageClient -t mock.tmpl -o hcloudtest/zz_image_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IISOClient -t mock.tmpl -o hcloudtest/zz_iso_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i ILocationClient -t mock.tmpl -o hcloudtest/zz_location_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i INetworkClient -t mock.tmpl -o hcloudtest/zz_network_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IPricingClient -t mock.tmpl -o hcloudtest/zz_pricing_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IServerClient -t mock.tmpl -o hcloudtest/zz_server_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IServerTypeClient -t mock.tmpl -o hcloudtest/zz_server_type_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i ISSHKeyClient -t mock.tmpl -o hcloudtest/zz_ssh_key_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IVolumeClient -t mock.tmpl -o hcloudtest/zz_volume_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i ILoadBalancerClient -t mock.tmpl -o hcloudtest/zz_load_balancer_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i ILoadBalancerTypeClient -t mock.tmpl -o hcloudtest/zz_load_balancer_type_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i ICertificateClient -t mock.tmpl -o hcloudtest/zz_certificate_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IFirewallClient -t mock.tmpl -o hcloudtest/zz_firewall_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IPlacementGroupClient -t mock.tmpl -o hcloudtest/zz_placement_group_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IRDNSClient -t mock.tmpl -o hcloudtest/zz_rdns_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IPrimaryIPClient -t mock.tmpl -o hcloudtest/zz_primary_ip_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IZoneClient -t mock.tmpl -o hcloudtest/zz_zone_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IStorageBoxTypeClient -t mock.tmpl -o hcloudtest/zz_storage_box_type_client_mock.go
+tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i IStorageBoxClient -t mock.tmpl -o hcloudtest/zz_storage_box_client_mock.go
+
 tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i converter -t schema.tmpl -o zz_schema.go
 tool github.com/jmattheis/goverter/cmd/goverter gen ./...
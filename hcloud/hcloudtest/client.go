@@ -0,0 +1,105 @@
+// Package hcloudtest provides mocks of the [hcloud] client interfaces, to test programs
+// using the [hcloud.Client] without an API.
+//
+// The mocks are generated from the I*Client interfaces, each method records its call
+// and calls a configurable stub function:
+//
+//	client := hcloudtest.NewClient()
+//	client.Server.GetByIDStub = func(ctx context.Context, id int64) (*hcloud.Server, *hcloud.Response, error) {
+//		return &hcloud.Server{ID: id}, nil, nil
+//	}
+//
+// The mocks are passed to the code under test with [Client.Facade].
+//
+// The [hcloud.ResourceActionClient] of a resource is not part of the [Client], its mock
+// is created for the resource type:
+//
+//	actions := &hcloudtest.MockResourceActionClient[*hcloud.Server]{}
+package hcloudtest
+
+import (
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// Client mirrors the subsystems of the [hcloud.Client] with mocks.
+type Client struct {
+	Action           *MockActionClient
+	Certificate      *MockCertificateClient
+	Firewall         *MockFirewallClient
+	FloatingIP       *MockFloatingIPClient
+	Image            *MockImageClient
+	ISO              *MockISOClient
+	LoadBalancer     *MockLoadBalancerClient
+	LoadBalancerType *MockLoadBalancerTypeClient
+	Location         *MockLocationClient
+	Network          *MockNetworkClient
+	Pricing          *MockPricingClient
+	Server           *MockServerClient
+	ServerType       *MockServerTypeClient
+	StorageBox       *MockStorageBoxClient
+	SSHKey           *MockSSHKeyClient
+	Volume           *MockVolumeClient
+	PlacementGroup   *MockPlacementGroupClient
+	RDNS             *MockRDNSClient
+	PrimaryIP        *MockPrimaryIPClient
+	StorageBoxType   *MockStorageBoxTypeClient
+	Zone             *MockZoneClient
+	Datacenter       *MockDatacenterClient
+}
+
+// NewClient returns a [Client] with a mock for each subsystem, without any stub
+// functions.
+func NewClient() *Client {
+	return &Client{
+		Action:           &MockActionClient{},
+		Certificate:      &MockCertificateClient{},
+		Firewall:         &MockFirewallClient{},
+		FloatingIP:       &MockFloatingIPClient{},
+		Image:            &MockImageClient{},
+		ISO:              &MockISOClient{},
+		LoadBalancer:     &MockLoadBalancerClient{},
+		LoadBalancerType: &MockLoadBalancerTypeClient{},
+		Location:         &MockLocationClient{},
+		Network:          &MockNetworkClient{},
+		Pricing:          &MockPricingClient{},
+		Server:           &MockServerClient{},
+		ServerType:       &MockServerTypeClient{},
+		StorageBox:       &MockStorageBoxClient{},
+		SSHKey:           &MockSSHKeyClient{},
+		Volume:           &MockVolumeClient{},
+		PlacementGroup:   &MockPlacementGroupClient{},
+		RDNS:             &MockRDNSClient{},
+		PrimaryIP:        &MockPrimaryIPClient{},
+		StorageBoxType:   &MockStorageBoxTypeClient{},
+		Zone:             &MockZoneClient{},
+		Datacenter:       &MockDatacenterClient{},
+	}
+}
+
+// Facade returns a [hcloud.ClientFacade] using the mocks of the [Client].
+func (c *Client) Facade() *hcloud.ClientFacade {
+	return &hcloud.ClientFacade{
+		Action:           c.Action,
+		Certificate:      c.Certificate,
+		Firewall:         c.Firewall,
+		FloatingIP:       c.FloatingIP,
+		Image:            c.Image,
+		ISO:              c.ISO,
+		LoadBalancer:     c.LoadBalancer,
+		LoadBalancerType: c.LoadBalancerType,
+		Location:         c.Location,
+		Network:          c.Network,
+		Pricing:          c.Pricing,
+		Server:           c.Server,
+		ServerType:       c.ServerType,
+		StorageBox:       c.StorageBox,
+		SSHKey:           c.SSHKey,
+		Volume:           c.Volume,
+		PlacementGroup:   c.PlacementGroup,
+		RDNS:             c.RDNS,
+		PrimaryIP:        c.PrimaryIP,
+		StorageBoxType:   c.StorageBoxType,
+		Zone:             c.Zone,
+		Datacenter:       c.Datacenter,
+	}
+}
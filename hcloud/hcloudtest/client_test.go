@@ -0,0 +1,80 @@
+package hcloudtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+
+	client.Server.GetByIDStub = func(_ context.Context, id int64) (*hcloud.Server, *hcloud.Response, error) {
+		return &hcloud.Server{ID: id}, nil, nil
+	}
+	client.Action.WaitForStub = func(_ context.Context, _ ...*hcloud.Action) error {
+		return nil
+	}
+
+	server, _, err := client.Server.GetByID(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), server.ID)
+
+	actions := []*hcloud.Action{{ID: 1}, {ID: 2}}
+	require.NoError(t, client.Action.WaitFor(ctx, actions...))
+
+	assert.Equal(t, []Call{{Method: "GetByID", Args: []any{ctx, int64(42)}}}, client.Server.Calls())
+	assert.Equal(t, []Call{{Method: "WaitFor", Args: []any{ctx, actions}}}, client.Action.CallsTo("WaitFor"))
+	assert.Empty(t, client.Action.CallsTo("WaitForFunc"))
+
+	client.Server.ResetCalls()
+	assert.Empty(t, client.Server.Calls())
+}
+
+func TestClientNotStubbed(t *testing.T) {
+	client := NewClient()
+
+	assert.PanicsWithValue(t, "hcloudtest: MockServerClient.Delete is not stubbed", func() {
+		_, _ = client.Server.Delete(context.Background(), &hcloud.Server{ID: 1})
+	})
+	// The call is recorded before panicking
+	assert.Len(t, client.Server.CallsTo("Delete"), 1)
+}
+
+func TestClientFacade(t *testing.T) {
+	client := NewClient()
+	client.Zone.GetByIDStub = func(_ context.Context, id int64) (*hcloud.Zone, *hcloud.Response, error) {
+		return &hcloud.Zone{ID: id}, nil, nil
+	}
+
+	facade := client.Facade()
+	assert.Same(t, client.Server, facade.Server)
+
+	zone, _, err := facade.Zone.GetByID(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), zone.ID)
+	assert.Len(t, client.Zone.Calls(), 1)
+}
+
+func TestMockResourceActionClient(t *testing.T) {
+	ctx := context.Background()
+	server := &hcloud.Server{ID: 1}
+
+	var actions hcloud.IResourceActionClient[*hcloud.Server] = &MockResourceActionClient[*hcloud.Server]{
+		ListForStub: func(_ context.Context, server *hcloud.Server, _ hcloud.ActionListOpts) ([]*hcloud.Action, *hcloud.Response, error) {
+			return []*hcloud.Action{{ID: server.ID * 10}}, nil, nil
+		},
+	}
+
+	result, _, err := actions.ListFor(ctx, server, hcloud.ActionListOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, []*hcloud.Action{{ID: 10}}, result)
+
+	mock := actions.(*MockResourceActionClient[*hcloud.Server])
+	assert.Equal(t, []Call{{Method: "ListFor", Args: []any{ctx, server, hcloud.ActionListOpts{}}}}, mock.Calls())
+}
@@ -0,0 +1,61 @@
+package hcloudtest
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Call is a method call recorded by a mock.
+type Call struct {
+	// Method is the name of the called method.
+	Method string
+	// Args are the arguments of the call, variadic arguments are passed as a slice.
+	Args []any
+}
+
+// recorder records the calls of a mock.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls recorded by the mock, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls of the method recorded by the mock, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]Call, 0)
+	for _, call := range r.calls {
+		if call.Method == method {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+// ResetCalls forgets the calls recorded by the mock.
+func (r *recorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func notStubbed(method string) string {
+	return fmt.Sprintf("hcloudtest: %s is not stubbed", method)
+}
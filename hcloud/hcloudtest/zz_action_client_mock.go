@@ -0,0 +1,122 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockActionClient is a mock of [_sourceHcloud.IActionClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockActionClient struct {
+	recorder
+
+	// AllStub stubs [MockActionClient.All].
+	AllStub func(ctx context.Context) (apa1 []*_sourceHcloud.Action, err error)
+	// AllWithOptsStub stubs [MockActionClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error)
+	// GetByIDStub stubs [MockActionClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockActionClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error])
+	// ListStub stubs [MockActionClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// WaitForStub stubs [MockActionClient.WaitFor].
+	WaitForStub func(ctx context.Context, actions ...*_sourceHcloud.Action) (err error)
+	// WaitForFuncStub stubs [MockActionClient.WaitForFunc].
+	WaitForFuncStub func(ctx context.Context, handleUpdate func(update *_sourceHcloud.Action) error, actions ...*_sourceHcloud.Action) (err error)
+	// WatchOverallProgressStub stubs [MockActionClient.WatchOverallProgress].
+	WatchOverallProgressStub func(ctx context.Context, actions []*_sourceHcloud.Action) (ch1 <-chan int, ch2 <-chan error)
+	// WatchProgressStub stubs [MockActionClient.WatchProgress].
+	WatchProgressStub func(ctx context.Context, action *_sourceHcloud.Action) (ch1 <-chan int, ch2 <-chan error)
+}
+
+var _ _sourceHcloud.IActionClient = (*MockActionClient)(nil)
+
+// All implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) All(ctx context.Context) (apa1 []*_sourceHcloud.Action, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockActionClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockActionClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// GetByID implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) GetByID(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockActionClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// Iter implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) Iter(ctx context.Context, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockActionClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) List(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockActionClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// WaitFor implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) WaitFor(ctx context.Context, actions ...*_sourceHcloud.Action) (err error) {
+	m.record("WaitFor", ctx, actions)
+	if m.WaitForStub == nil {
+		panic(notStubbed("MockActionClient.WaitFor"))
+	}
+	return m.WaitForStub(ctx, actions...)
+}
+
+// WaitForFunc implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update *_sourceHcloud.Action) error, actions ...*_sourceHcloud.Action) (err error) {
+	m.record("WaitForFunc", ctx, handleUpdate, actions)
+	if m.WaitForFuncStub == nil {
+		panic(notStubbed("MockActionClient.WaitForFunc"))
+	}
+	return m.WaitForFuncStub(ctx, handleUpdate, actions...)
+}
+
+// WatchOverallProgress implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) WatchOverallProgress(ctx context.Context, actions []*_sourceHcloud.Action) (ch1 <-chan int, ch2 <-chan error) {
+	m.record("WatchOverallProgress", ctx, actions)
+	if m.WatchOverallProgressStub == nil {
+		panic(notStubbed("MockActionClient.WatchOverallProgress"))
+	}
+	return m.WatchOverallProgressStub(ctx, actions)
+}
+
+// WatchProgress implements [_sourceHcloud.IActionClient].
+func (m *MockActionClient) WatchProgress(ctx context.Context, action *_sourceHcloud.Action) (ch1 <-chan int, ch2 <-chan error) {
+	m.record("WatchProgress", ctx, action)
+	if m.WatchProgressStub == nil {
+		panic(notStubbed("MockActionClient.WatchProgress"))
+	}
+	return m.WatchProgressStub(ctx, action)
+}
@@ -0,0 +1,155 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockCertificateClient is a mock of [_sourceHcloud.ICertificateClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockCertificateClient struct {
+	recorder
+
+	// AllStub stubs [MockCertificateClient.All].
+	AllStub func(ctx context.Context) (cpa1 []*_sourceHcloud.Certificate, err error)
+	// AllWithOptsStub stubs [MockCertificateClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (cpa1 []*_sourceHcloud.Certificate, err error)
+	// CreateStub stubs [MockCertificateClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.CertificateCreateOpts) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+	// CreateCertificateStub stubs [MockCertificateClient.CreateCertificate].
+	CreateCertificateStub func(ctx context.Context, opts _sourceHcloud.CertificateCreateOpts) (c2 _sourceHcloud.CertificateCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockCertificateClient.Delete].
+	DeleteStub func(ctx context.Context, certificate *_sourceHcloud.Certificate) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockCertificateClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockCertificateClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockCertificateClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockCertificateClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (p1 iter.Seq2[*_sourceHcloud.Certificate, error])
+	// ListStub stubs [MockCertificateClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (cpa1 []*_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+	// RetryIssuanceStub stubs [MockCertificateClient.RetryIssuance].
+	RetryIssuanceStub func(ctx context.Context, certificate *_sourceHcloud.Certificate) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockCertificateClient.Update].
+	UpdateStub func(ctx context.Context, certificate *_sourceHcloud.Certificate, opts _sourceHcloud.CertificateUpdateOpts) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.ICertificateClient = (*MockCertificateClient)(nil)
+
+// All implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) All(ctx context.Context) (cpa1 []*_sourceHcloud.Certificate, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockCertificateClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (cpa1 []*_sourceHcloud.Certificate, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockCertificateClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Create implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) Create(ctx context.Context, opts _sourceHcloud.CertificateCreateOpts) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockCertificateClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// CreateCertificate implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) CreateCertificate(ctx context.Context, opts _sourceHcloud.CertificateCreateOpts) (c2 _sourceHcloud.CertificateCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateCertificate", ctx, opts)
+	if m.CreateCertificateStub == nil {
+		panic(notStubbed("MockCertificateClient.CreateCertificate"))
+	}
+	return m.CreateCertificateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) Delete(ctx context.Context, certificate *_sourceHcloud.Certificate) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, certificate)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockCertificateClient.Delete"))
+	}
+	return m.DeleteStub(ctx, certificate)
+}
+
+// Get implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) Get(ctx context.Context, idOrName string) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockCertificateClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) GetByID(ctx context.Context, id int64) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockCertificateClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) GetByName(ctx context.Context, name string) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockCertificateClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) Iter(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (p1 iter.Seq2[*_sourceHcloud.Certificate, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockCertificateClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) List(ctx context.Context, opts _sourceHcloud.CertificateListOpts) (cpa1 []*_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockCertificateClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// RetryIssuance implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) RetryIssuance(ctx context.Context, certificate *_sourceHcloud.Certificate) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RetryIssuance", ctx, certificate)
+	if m.RetryIssuanceStub == nil {
+		panic(notStubbed("MockCertificateClient.RetryIssuance"))
+	}
+	return m.RetryIssuanceStub(ctx, certificate)
+}
+
+// Update implements [_sourceHcloud.ICertificateClient].
+func (m *MockCertificateClient) Update(ctx context.Context, certificate *_sourceHcloud.Certificate, opts _sourceHcloud.CertificateUpdateOpts) (cp1 *_sourceHcloud.Certificate, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, certificate, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockCertificateClient.Update"))
+	}
+	return m.UpdateStub(ctx, certificate, opts)
+}
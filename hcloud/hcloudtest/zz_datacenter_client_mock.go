@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockDatacenterClient is a mock of [_sourceHcloud.IDatacenterClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockDatacenterClient struct {
+	recorder
+
+	// AllStub stubs [MockDatacenterClient.All].
+	AllStub func(ctx context.Context) (dpa1 []*_sourceHcloud.Datacenter, err error)
+	// AllWithOptsStub stubs [MockDatacenterClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (dpa1 []*_sourceHcloud.Datacenter, err error)
+	// GetStub stubs [MockDatacenterClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockDatacenterClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockDatacenterClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockDatacenterClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (p1 iter.Seq2[*_sourceHcloud.Datacenter, error])
+	// ListStub stubs [MockDatacenterClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (dpa1 []*_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IDatacenterClient = (*MockDatacenterClient)(nil)
+
+// All implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) All(ctx context.Context) (dpa1 []*_sourceHcloud.Datacenter, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockDatacenterClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (dpa1 []*_sourceHcloud.Datacenter, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockDatacenterClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) Get(ctx context.Context, idOrName string) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockDatacenterClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) GetByID(ctx context.Context, id int64) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockDatacenterClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) GetByName(ctx context.Context, name string) (dp1 *_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockDatacenterClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) Iter(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (p1 iter.Seq2[*_sourceHcloud.Datacenter, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockDatacenterClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IDatacenterClient].
+func (m *MockDatacenterClient) List(ctx context.Context, opts _sourceHcloud.DatacenterListOpts) (dpa1 []*_sourceHcloud.Datacenter, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockDatacenterClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
@@ -0,0 +1,188 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockFirewallClient is a mock of [_sourceHcloud.IFirewallClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockFirewallClient struct {
+	recorder
+
+	// AllStub stubs [MockFirewallClient.All].
+	AllStub func(ctx context.Context) (fpa1 []*_sourceHcloud.Firewall, err error)
+	// AllWithOptsStub stubs [MockFirewallClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (fpa1 []*_sourceHcloud.Firewall, err error)
+	// ApplyResourcesStub stubs [MockFirewallClient.ApplyResources].
+	ApplyResourcesStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, resources []_sourceHcloud.FirewallResource) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockFirewallClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.FirewallCreateOpts) (f1 _sourceHcloud.FirewallCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockFirewallClient.Delete].
+	DeleteStub func(ctx context.Context, firewall *_sourceHcloud.Firewall) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockFirewallClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockFirewallClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockFirewallClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockFirewallClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (p1 iter.Seq2[*_sourceHcloud.Firewall, error])
+	// ListStub stubs [MockFirewallClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (fpa1 []*_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error)
+	// PlanStub stubs [MockFirewallClient.Plan].
+	PlanStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallReconcileOpts) (f1 _sourceHcloud.FirewallDiff, rp1 *_sourceHcloud.Response, err error)
+	// ReconcileStub stubs [MockFirewallClient.Reconcile].
+	ReconcileStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallReconcileOpts) (f1 _sourceHcloud.FirewallReconcileResult, rp1 *_sourceHcloud.Response, err error)
+	// RemoveResourcesStub stubs [MockFirewallClient.RemoveResources].
+	RemoveResourcesStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, resources []_sourceHcloud.FirewallResource) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// SetRulesStub stubs [MockFirewallClient.SetRules].
+	SetRulesStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallSetRulesOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockFirewallClient.Update].
+	UpdateStub func(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallUpdateOpts) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IFirewallClient = (*MockFirewallClient)(nil)
+
+// All implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) All(ctx context.Context) (fpa1 []*_sourceHcloud.Firewall, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockFirewallClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (fpa1 []*_sourceHcloud.Firewall, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockFirewallClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// ApplyResources implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) ApplyResources(ctx context.Context, firewall *_sourceHcloud.Firewall, resources []_sourceHcloud.FirewallResource) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ApplyResources", ctx, firewall, resources)
+	if m.ApplyResourcesStub == nil {
+		panic(notStubbed("MockFirewallClient.ApplyResources"))
+	}
+	return m.ApplyResourcesStub(ctx, firewall, resources)
+}
+
+// Create implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Create(ctx context.Context, opts _sourceHcloud.FirewallCreateOpts) (f1 _sourceHcloud.FirewallCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockFirewallClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Delete(ctx context.Context, firewall *_sourceHcloud.Firewall) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, firewall)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockFirewallClient.Delete"))
+	}
+	return m.DeleteStub(ctx, firewall)
+}
+
+// Get implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Get(ctx context.Context, idOrName string) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockFirewallClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) GetByID(ctx context.Context, id int64) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockFirewallClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) GetByName(ctx context.Context, name string) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockFirewallClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Iter(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (p1 iter.Seq2[*_sourceHcloud.Firewall, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockFirewallClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) List(ctx context.Context, opts _sourceHcloud.FirewallListOpts) (fpa1 []*_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockFirewallClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Plan implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Plan(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallReconcileOpts) (f1 _sourceHcloud.FirewallDiff, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Plan", ctx, firewall, opts)
+	if m.PlanStub == nil {
+		panic(notStubbed("MockFirewallClient.Plan"))
+	}
+	return m.PlanStub(ctx, firewall, opts)
+}
+
+// Reconcile implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Reconcile(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallReconcileOpts) (f1 _sourceHcloud.FirewallReconcileResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Reconcile", ctx, firewall, opts)
+	if m.ReconcileStub == nil {
+		panic(notStubbed("MockFirewallClient.Reconcile"))
+	}
+	return m.ReconcileStub(ctx, firewall, opts)
+}
+
+// RemoveResources implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) RemoveResources(ctx context.Context, firewall *_sourceHcloud.Firewall, resources []_sourceHcloud.FirewallResource) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveResources", ctx, firewall, resources)
+	if m.RemoveResourcesStub == nil {
+		panic(notStubbed("MockFirewallClient.RemoveResources"))
+	}
+	return m.RemoveResourcesStub(ctx, firewall, resources)
+}
+
+// SetRules implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) SetRules(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallSetRulesOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("SetRules", ctx, firewall, opts)
+	if m.SetRulesStub == nil {
+		panic(notStubbed("MockFirewallClient.SetRules"))
+	}
+	return m.SetRulesStub(ctx, firewall, opts)
+}
+
+// Update implements [_sourceHcloud.IFirewallClient].
+func (m *MockFirewallClient) Update(ctx context.Context, firewall *_sourceHcloud.Firewall, opts _sourceHcloud.FirewallUpdateOpts) (fp1 *_sourceHcloud.Firewall, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, firewall, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockFirewallClient.Update"))
+	}
+	return m.UpdateStub(ctx, firewall, opts)
+}
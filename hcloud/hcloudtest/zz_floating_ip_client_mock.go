@@ -0,0 +1,177 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockFloatingIPClient is a mock of [_sourceHcloud.IFloatingIPClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockFloatingIPClient struct {
+	recorder
+
+	// AllStub stubs [MockFloatingIPClient.All].
+	AllStub func(ctx context.Context) (fpa1 []*_sourceHcloud.FloatingIP, err error)
+	// AllWithOptsStub stubs [MockFloatingIPClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (fpa1 []*_sourceHcloud.FloatingIP, err error)
+	// AssignStub stubs [MockFloatingIPClient.Assign].
+	AssignStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeDNSPtrStub stubs [MockFloatingIPClient.ChangeDNSPtr].
+	ChangeDNSPtrStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockFloatingIPClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, opts _sourceHcloud.FloatingIPChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockFloatingIPClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.FloatingIPCreateOpts) (f1 _sourceHcloud.FloatingIPCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockFloatingIPClient.Delete].
+	DeleteStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockFloatingIPClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockFloatingIPClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockFloatingIPClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockFloatingIPClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (p1 iter.Seq2[*_sourceHcloud.FloatingIP, error])
+	// ListStub stubs [MockFloatingIPClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (fpa1 []*_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error)
+	// UnassignStub stubs [MockFloatingIPClient.Unassign].
+	UnassignStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockFloatingIPClient.Update].
+	UpdateStub func(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, opts _sourceHcloud.FloatingIPUpdateOpts) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IFloatingIPClient = (*MockFloatingIPClient)(nil)
+
+// All implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) All(ctx context.Context) (fpa1 []*_sourceHcloud.FloatingIP, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockFloatingIPClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (fpa1 []*_sourceHcloud.FloatingIP, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockFloatingIPClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Assign implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Assign(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Assign", ctx, floatingIP, server)
+	if m.AssignStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Assign"))
+	}
+	return m.AssignStub(ctx, floatingIP, server)
+}
+
+// ChangeDNSPtr implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) ChangeDNSPtr(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeDNSPtr", ctx, floatingIP, ip, ptr)
+	if m.ChangeDNSPtrStub == nil {
+		panic(notStubbed("MockFloatingIPClient.ChangeDNSPtr"))
+	}
+	return m.ChangeDNSPtrStub(ctx, floatingIP, ip, ptr)
+}
+
+// ChangeProtection implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) ChangeProtection(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, opts _sourceHcloud.FloatingIPChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, floatingIP, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockFloatingIPClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, floatingIP, opts)
+}
+
+// Create implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Create(ctx context.Context, opts _sourceHcloud.FloatingIPCreateOpts) (f1 _sourceHcloud.FloatingIPCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Delete(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, floatingIP)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Delete"))
+	}
+	return m.DeleteStub(ctx, floatingIP)
+}
+
+// Get implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Get(ctx context.Context, idOrName string) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) GetByID(ctx context.Context, id int64) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockFloatingIPClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) GetByName(ctx context.Context, name string) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockFloatingIPClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Iter(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (p1 iter.Seq2[*_sourceHcloud.FloatingIP, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) List(ctx context.Context, opts _sourceHcloud.FloatingIPListOpts) (fpa1 []*_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockFloatingIPClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Unassign implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Unassign(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Unassign", ctx, floatingIP)
+	if m.UnassignStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Unassign"))
+	}
+	return m.UnassignStub(ctx, floatingIP)
+}
+
+// Update implements [_sourceHcloud.IFloatingIPClient].
+func (m *MockFloatingIPClient) Update(ctx context.Context, floatingIP *_sourceHcloud.FloatingIP, opts _sourceHcloud.FloatingIPUpdateOpts) (fp1 *_sourceHcloud.FloatingIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, floatingIP, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockFloatingIPClient.Update"))
+	}
+	return m.UpdateStub(ctx, floatingIP, opts)
+}
@@ -0,0 +1,155 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockImageClient is a mock of [_sourceHcloud.IImageClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockImageClient struct {
+	recorder
+
+	// AllStub stubs [MockImageClient.All].
+	AllStub func(ctx context.Context) (ipa1 []*_sourceHcloud.Image, err error)
+	// AllWithOptsStub stubs [MockImageClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ImageListOpts) (ipa1 []*_sourceHcloud.Image, err error)
+	// ChangeProtectionStub stubs [MockImageClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, image *_sourceHcloud.Image, opts _sourceHcloud.ImageChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockImageClient.Delete].
+	DeleteStub func(ctx context.Context, image *_sourceHcloud.Image) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockImageClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockImageClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockImageClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameAndArchitectureStub stubs [MockImageClient.GetByNameAndArchitecture].
+	GetByNameAndArchitectureStub func(ctx context.Context, name string, architecture _sourceHcloud.Architecture) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// GetForArchitectureStub stubs [MockImageClient.GetForArchitecture].
+	GetForArchitectureStub func(ctx context.Context, idOrName string, architecture _sourceHcloud.Architecture) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockImageClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ImageListOpts) (p1 iter.Seq2[*_sourceHcloud.Image, error])
+	// ListStub stubs [MockImageClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ImageListOpts) (ipa1 []*_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockImageClient.Update].
+	UpdateStub func(ctx context.Context, image *_sourceHcloud.Image, opts _sourceHcloud.ImageUpdateOpts) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IImageClient = (*MockImageClient)(nil)
+
+// All implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) All(ctx context.Context) (ipa1 []*_sourceHcloud.Image, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockImageClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ImageListOpts) (ipa1 []*_sourceHcloud.Image, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockImageClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) ChangeProtection(ctx context.Context, image *_sourceHcloud.Image, opts _sourceHcloud.ImageChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, image, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockImageClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, image, opts)
+}
+
+// Delete implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) Delete(ctx context.Context, image *_sourceHcloud.Image) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, image)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockImageClient.Delete"))
+	}
+	return m.DeleteStub(ctx, image)
+}
+
+// Get implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) Get(ctx context.Context, idOrName string) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockImageClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) GetByID(ctx context.Context, id int64) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockImageClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) GetByName(ctx context.Context, name string) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockImageClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// GetByNameAndArchitecture implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) GetByNameAndArchitecture(ctx context.Context, name string, architecture _sourceHcloud.Architecture) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByNameAndArchitecture", ctx, name, architecture)
+	if m.GetByNameAndArchitectureStub == nil {
+		panic(notStubbed("MockImageClient.GetByNameAndArchitecture"))
+	}
+	return m.GetByNameAndArchitectureStub(ctx, name, architecture)
+}
+
+// GetForArchitecture implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) GetForArchitecture(ctx context.Context, idOrName string, architecture _sourceHcloud.Architecture) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetForArchitecture", ctx, idOrName, architecture)
+	if m.GetForArchitectureStub == nil {
+		panic(notStubbed("MockImageClient.GetForArchitecture"))
+	}
+	return m.GetForArchitectureStub(ctx, idOrName, architecture)
+}
+
+// Iter implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) Iter(ctx context.Context, opts _sourceHcloud.ImageListOpts) (p1 iter.Seq2[*_sourceHcloud.Image, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockImageClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) List(ctx context.Context, opts _sourceHcloud.ImageListOpts) (ipa1 []*_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockImageClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Update implements [_sourceHcloud.IImageClient].
+func (m *MockImageClient) Update(ctx context.Context, image *_sourceHcloud.Image, opts _sourceHcloud.ImageUpdateOpts) (ip1 *_sourceHcloud.Image, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, image, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockImageClient.Update"))
+	}
+	return m.UpdateStub(ctx, image, opts)
+}
@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockISOClient is a mock of [_sourceHcloud.IISOClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockISOClient struct {
+	recorder
+
+	// AllStub stubs [MockISOClient.All].
+	AllStub func(ctx context.Context) (ipa1 []*_sourceHcloud.ISO, err error)
+	// AllWithOptsStub stubs [MockISOClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ISOListOpts) (ipa1 []*_sourceHcloud.ISO, err error)
+	// GetStub stubs [MockISOClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockISOClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockISOClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockISOClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ISOListOpts) (p1 iter.Seq2[*_sourceHcloud.ISO, error])
+	// ListStub stubs [MockISOClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ISOListOpts) (ipa1 []*_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IISOClient = (*MockISOClient)(nil)
+
+// All implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) All(ctx context.Context) (ipa1 []*_sourceHcloud.ISO, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockISOClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ISOListOpts) (ipa1 []*_sourceHcloud.ISO, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockISOClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) Get(ctx context.Context, idOrName string) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockISOClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) GetByID(ctx context.Context, id int64) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockISOClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) GetByName(ctx context.Context, name string) (ip1 *_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockISOClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) Iter(ctx context.Context, opts _sourceHcloud.ISOListOpts) (p1 iter.Seq2[*_sourceHcloud.ISO, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockISOClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IISOClient].
+func (m *MockISOClient) List(ctx context.Context, opts _sourceHcloud.ISOListOpts) (ipa1 []*_sourceHcloud.ISO, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockISOClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
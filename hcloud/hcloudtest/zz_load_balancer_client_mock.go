@@ -0,0 +1,354 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+	"net"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockLoadBalancerClient is a mock of [_sourceHcloud.ILoadBalancerClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockLoadBalancerClient struct {
+	recorder
+
+	// AddIPTargetStub stubs [MockLoadBalancerClient.AddIPTarget].
+	AddIPTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddIPTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AddLabelSelectorTargetStub stubs [MockLoadBalancerClient.AddLabelSelectorTarget].
+	AddLabelSelectorTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddLabelSelectorTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AddServerTargetStub stubs [MockLoadBalancerClient.AddServerTarget].
+	AddServerTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddServerTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AddServiceStub stubs [MockLoadBalancerClient.AddService].
+	AddServiceStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddServiceOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AllStub stubs [MockLoadBalancerClient.All].
+	AllStub func(ctx context.Context) (lpa1 []*_sourceHcloud.LoadBalancer, err error)
+	// AllWithOptsStub stubs [MockLoadBalancerClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (lpa1 []*_sourceHcloud.LoadBalancer, err error)
+	// AttachToNetworkStub stubs [MockLoadBalancerClient.AttachToNetwork].
+	AttachToNetworkStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAttachToNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeAlgorithmStub stubs [MockLoadBalancerClient.ChangeAlgorithm].
+	ChangeAlgorithmStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeAlgorithmOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeDNSPtrStub stubs [MockLoadBalancerClient.ChangeDNSPtr].
+	ChangeDNSPtrStub func(ctx context.Context, lb *_sourceHcloud.LoadBalancer, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockLoadBalancerClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeTypeStub stubs [MockLoadBalancerClient.ChangeType].
+	ChangeTypeStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockLoadBalancerClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerCreateOpts) (l1 _sourceHcloud.LoadBalancerCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockLoadBalancerClient.Delete].
+	DeleteStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (rp1 *_sourceHcloud.Response, err error)
+	// DeleteServiceStub stubs [MockLoadBalancerClient.DeleteService].
+	DeleteServiceStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, listenPort int) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DetachFromNetworkStub stubs [MockLoadBalancerClient.DetachFromNetwork].
+	DetachFromNetworkStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerDetachFromNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DisablePublicInterfaceStub stubs [MockLoadBalancerClient.DisablePublicInterface].
+	DisablePublicInterfaceStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// EnablePublicInterfaceStub stubs [MockLoadBalancerClient.EnablePublicInterface].
+	EnablePublicInterfaceStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockLoadBalancerClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockLoadBalancerClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockLoadBalancerClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error)
+	// GetMetricsStub stubs [MockLoadBalancerClient.GetMetrics].
+	GetMetricsStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerGetMetricsOpts) (lp1 *_sourceHcloud.LoadBalancerMetrics, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockLoadBalancerClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (p1 iter.Seq2[*_sourceHcloud.LoadBalancer, error])
+	// ListStub stubs [MockLoadBalancerClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (lpa1 []*_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error)
+	// PlanStub stubs [MockLoadBalancerClient.Plan].
+	PlanStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerReconcileOpts) (l1 _sourceHcloud.LoadBalancerDiff, rp1 *_sourceHcloud.Response, err error)
+	// ReconcileStub stubs [MockLoadBalancerClient.Reconcile].
+	ReconcileStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerReconcileOpts) (l1 _sourceHcloud.LoadBalancerReconcileResult, rp1 *_sourceHcloud.Response, err error)
+	// RemoveIPTargetStub stubs [MockLoadBalancerClient.RemoveIPTarget].
+	RemoveIPTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, ip net.IP) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RemoveLabelSelectorTargetStub stubs [MockLoadBalancerClient.RemoveLabelSelectorTarget].
+	RemoveLabelSelectorTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, labelSelector string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RemoveServerTargetStub stubs [MockLoadBalancerClient.RemoveServerTarget].
+	RemoveServerTargetStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockLoadBalancerClient.Update].
+	UpdateStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerUpdateOpts) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error)
+	// UpdateServiceStub stubs [MockLoadBalancerClient.UpdateService].
+	UpdateServiceStub func(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, listenPort int, opts _sourceHcloud.LoadBalancerUpdateServiceOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.ILoadBalancerClient = (*MockLoadBalancerClient)(nil)
+
+// AddIPTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AddIPTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddIPTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddIPTarget", ctx, loadBalancer, opts)
+	if m.AddIPTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AddIPTarget"))
+	}
+	return m.AddIPTargetStub(ctx, loadBalancer, opts)
+}
+
+// AddLabelSelectorTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AddLabelSelectorTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddLabelSelectorTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddLabelSelectorTarget", ctx, loadBalancer, opts)
+	if m.AddLabelSelectorTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AddLabelSelectorTarget"))
+	}
+	return m.AddLabelSelectorTargetStub(ctx, loadBalancer, opts)
+}
+
+// AddServerTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AddServerTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddServerTargetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddServerTarget", ctx, loadBalancer, opts)
+	if m.AddServerTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AddServerTarget"))
+	}
+	return m.AddServerTargetStub(ctx, loadBalancer, opts)
+}
+
+// AddService implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AddService(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAddServiceOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddService", ctx, loadBalancer, opts)
+	if m.AddServiceStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AddService"))
+	}
+	return m.AddServiceStub(ctx, loadBalancer, opts)
+}
+
+// All implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) All(ctx context.Context) (lpa1 []*_sourceHcloud.LoadBalancer, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (lpa1 []*_sourceHcloud.LoadBalancer, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// AttachToNetwork implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) AttachToNetwork(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerAttachToNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AttachToNetwork", ctx, loadBalancer, opts)
+	if m.AttachToNetworkStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.AttachToNetwork"))
+	}
+	return m.AttachToNetworkStub(ctx, loadBalancer, opts)
+}
+
+// ChangeAlgorithm implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) ChangeAlgorithm(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeAlgorithmOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeAlgorithm", ctx, loadBalancer, opts)
+	if m.ChangeAlgorithmStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.ChangeAlgorithm"))
+	}
+	return m.ChangeAlgorithmStub(ctx, loadBalancer, opts)
+}
+
+// ChangeDNSPtr implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) ChangeDNSPtr(ctx context.Context, lb *_sourceHcloud.LoadBalancer, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeDNSPtr", ctx, lb, ip, ptr)
+	if m.ChangeDNSPtrStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.ChangeDNSPtr"))
+	}
+	return m.ChangeDNSPtrStub(ctx, lb, ip, ptr)
+}
+
+// ChangeProtection implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) ChangeProtection(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, loadBalancer, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, loadBalancer, opts)
+}
+
+// ChangeType implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) ChangeType(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeType", ctx, loadBalancer, opts)
+	if m.ChangeTypeStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.ChangeType"))
+	}
+	return m.ChangeTypeStub(ctx, loadBalancer, opts)
+}
+
+// Create implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Create(ctx context.Context, opts _sourceHcloud.LoadBalancerCreateOpts) (l1 _sourceHcloud.LoadBalancerCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Delete(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, loadBalancer)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Delete"))
+	}
+	return m.DeleteStub(ctx, loadBalancer)
+}
+
+// DeleteService implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) DeleteService(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, listenPort int) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteService", ctx, loadBalancer, listenPort)
+	if m.DeleteServiceStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.DeleteService"))
+	}
+	return m.DeleteServiceStub(ctx, loadBalancer, listenPort)
+}
+
+// DetachFromNetwork implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) DetachFromNetwork(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerDetachFromNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DetachFromNetwork", ctx, loadBalancer, opts)
+	if m.DetachFromNetworkStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.DetachFromNetwork"))
+	}
+	return m.DetachFromNetworkStub(ctx, loadBalancer, opts)
+}
+
+// DisablePublicInterface implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) DisablePublicInterface(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DisablePublicInterface", ctx, loadBalancer)
+	if m.DisablePublicInterfaceStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.DisablePublicInterface"))
+	}
+	return m.DisablePublicInterfaceStub(ctx, loadBalancer)
+}
+
+// EnablePublicInterface implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) EnablePublicInterface(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("EnablePublicInterface", ctx, loadBalancer)
+	if m.EnablePublicInterfaceStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.EnablePublicInterface"))
+	}
+	return m.EnablePublicInterfaceStub(ctx, loadBalancer)
+}
+
+// Get implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Get(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) GetByID(ctx context.Context, id int64) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) GetByName(ctx context.Context, name string) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// GetMetrics implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) GetMetrics(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerGetMetricsOpts) (lp1 *_sourceHcloud.LoadBalancerMetrics, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetMetrics", ctx, loadBalancer, opts)
+	if m.GetMetricsStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.GetMetrics"))
+	}
+	return m.GetMetricsStub(ctx, loadBalancer, opts)
+}
+
+// Iter implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Iter(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (p1 iter.Seq2[*_sourceHcloud.LoadBalancer, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) List(ctx context.Context, opts _sourceHcloud.LoadBalancerListOpts) (lpa1 []*_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Plan implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Plan(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerReconcileOpts) (l1 _sourceHcloud.LoadBalancerDiff, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Plan", ctx, loadBalancer, opts)
+	if m.PlanStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Plan"))
+	}
+	return m.PlanStub(ctx, loadBalancer, opts)
+}
+
+// Reconcile implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Reconcile(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerReconcileOpts) (l1 _sourceHcloud.LoadBalancerReconcileResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Reconcile", ctx, loadBalancer, opts)
+	if m.ReconcileStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Reconcile"))
+	}
+	return m.ReconcileStub(ctx, loadBalancer, opts)
+}
+
+// RemoveIPTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) RemoveIPTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, ip net.IP) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveIPTarget", ctx, loadBalancer, ip)
+	if m.RemoveIPTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.RemoveIPTarget"))
+	}
+	return m.RemoveIPTargetStub(ctx, loadBalancer, ip)
+}
+
+// RemoveLabelSelectorTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) RemoveLabelSelectorTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, labelSelector string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveLabelSelectorTarget", ctx, loadBalancer, labelSelector)
+	if m.RemoveLabelSelectorTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.RemoveLabelSelectorTarget"))
+	}
+	return m.RemoveLabelSelectorTargetStub(ctx, loadBalancer, labelSelector)
+}
+
+// RemoveServerTarget implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) RemoveServerTarget(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveServerTarget", ctx, loadBalancer, server)
+	if m.RemoveServerTargetStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.RemoveServerTarget"))
+	}
+	return m.RemoveServerTargetStub(ctx, loadBalancer, server)
+}
+
+// Update implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) Update(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, opts _sourceHcloud.LoadBalancerUpdateOpts) (lp1 *_sourceHcloud.LoadBalancer, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, loadBalancer, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.Update"))
+	}
+	return m.UpdateStub(ctx, loadBalancer, opts)
+}
+
+// UpdateService implements [_sourceHcloud.ILoadBalancerClient].
+func (m *MockLoadBalancerClient) UpdateService(ctx context.Context, loadBalancer *_sourceHcloud.LoadBalancer, listenPort int, opts _sourceHcloud.LoadBalancerUpdateServiceOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateService", ctx, loadBalancer, listenPort, opts)
+	if m.UpdateServiceStub == nil {
+		panic(notStubbed("MockLoadBalancerClient.UpdateService"))
+	}
+	return m.UpdateServiceStub(ctx, loadBalancer, listenPort, opts)
+}
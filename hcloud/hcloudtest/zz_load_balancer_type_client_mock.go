@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockLoadBalancerTypeClient is a mock of [_sourceHcloud.ILoadBalancerTypeClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockLoadBalancerTypeClient struct {
+	recorder
+
+	// AllStub stubs [MockLoadBalancerTypeClient.All].
+	AllStub func(ctx context.Context) (lpa1 []*_sourceHcloud.LoadBalancerType, err error)
+	// AllWithOptsStub stubs [MockLoadBalancerTypeClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (lpa1 []*_sourceHcloud.LoadBalancerType, err error)
+	// GetStub stubs [MockLoadBalancerTypeClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockLoadBalancerTypeClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockLoadBalancerTypeClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockLoadBalancerTypeClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.LoadBalancerType, error])
+	// ListStub stubs [MockLoadBalancerTypeClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (lpa1 []*_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.ILoadBalancerTypeClient = (*MockLoadBalancerTypeClient)(nil)
+
+// All implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) All(ctx context.Context) (lpa1 []*_sourceHcloud.LoadBalancerType, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (lpa1 []*_sourceHcloud.LoadBalancerType, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) Get(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) GetByID(ctx context.Context, id int64) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) GetByName(ctx context.Context, name string) (lp1 *_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) Iter(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.LoadBalancerType, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.ILoadBalancerTypeClient].
+func (m *MockLoadBalancerTypeClient) List(ctx context.Context, opts _sourceHcloud.LoadBalancerTypeListOpts) (lpa1 []*_sourceHcloud.LoadBalancerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockLoadBalancerTypeClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockLocationClient is a mock of [_sourceHcloud.ILocationClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockLocationClient struct {
+	recorder
+
+	// AllStub stubs [MockLocationClient.All].
+	AllStub func(ctx context.Context) (lpa1 []*_sourceHcloud.Location, err error)
+	// AllWithOptsStub stubs [MockLocationClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.LocationListOpts) (lpa1 []*_sourceHcloud.Location, err error)
+	// GetStub stubs [MockLocationClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockLocationClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockLocationClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockLocationClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.LocationListOpts) (p1 iter.Seq2[*_sourceHcloud.Location, error])
+	// ListStub stubs [MockLocationClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.LocationListOpts) (lpa1 []*_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.ILocationClient = (*MockLocationClient)(nil)
+
+// All implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) All(ctx context.Context) (lpa1 []*_sourceHcloud.Location, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockLocationClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.LocationListOpts) (lpa1 []*_sourceHcloud.Location, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockLocationClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) Get(ctx context.Context, idOrName string) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockLocationClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) GetByID(ctx context.Context, id int64) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockLocationClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) GetByName(ctx context.Context, name string) (lp1 *_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockLocationClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) Iter(ctx context.Context, opts _sourceHcloud.LocationListOpts) (p1 iter.Seq2[*_sourceHcloud.Location, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockLocationClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.ILocationClient].
+func (m *MockLocationClient) List(ctx context.Context, opts _sourceHcloud.LocationListOpts) (lpa1 []*_sourceHcloud.Location, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockLocationClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
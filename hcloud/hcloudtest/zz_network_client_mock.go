@@ -0,0 +1,199 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockNetworkClient is a mock of [_sourceHcloud.INetworkClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockNetworkClient struct {
+	recorder
+
+	// AddRouteStub stubs [MockNetworkClient.AddRoute].
+	AddRouteStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkAddRouteOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AddSubnetStub stubs [MockNetworkClient.AddSubnet].
+	AddSubnetStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkAddSubnetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AllStub stubs [MockNetworkClient.All].
+	AllStub func(ctx context.Context) (npa1 []*_sourceHcloud.Network, err error)
+	// AllWithOptsStub stubs [MockNetworkClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (npa1 []*_sourceHcloud.Network, err error)
+	// ChangeIPRangeStub stubs [MockNetworkClient.ChangeIPRange].
+	ChangeIPRangeStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkChangeIPRangeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockNetworkClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockNetworkClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.NetworkCreateOpts) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockNetworkClient.Delete].
+	DeleteStub func(ctx context.Context, network *_sourceHcloud.Network) (rp1 *_sourceHcloud.Response, err error)
+	// DeleteRouteStub stubs [MockNetworkClient.DeleteRoute].
+	DeleteRouteStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkDeleteRouteOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DeleteSubnetStub stubs [MockNetworkClient.DeleteSubnet].
+	DeleteSubnetStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkDeleteSubnetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockNetworkClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockNetworkClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockNetworkClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockNetworkClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (p1 iter.Seq2[*_sourceHcloud.Network, error])
+	// ListStub stubs [MockNetworkClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (npa1 []*_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockNetworkClient.Update].
+	UpdateStub func(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkUpdateOpts) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.INetworkClient = (*MockNetworkClient)(nil)
+
+// AddRoute implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) AddRoute(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkAddRouteOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddRoute", ctx, network, opts)
+	if m.AddRouteStub == nil {
+		panic(notStubbed("MockNetworkClient.AddRoute"))
+	}
+	return m.AddRouteStub(ctx, network, opts)
+}
+
+// AddSubnet implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) AddSubnet(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkAddSubnetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddSubnet", ctx, network, opts)
+	if m.AddSubnetStub == nil {
+		panic(notStubbed("MockNetworkClient.AddSubnet"))
+	}
+	return m.AddSubnetStub(ctx, network, opts)
+}
+
+// All implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) All(ctx context.Context) (npa1 []*_sourceHcloud.Network, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockNetworkClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (npa1 []*_sourceHcloud.Network, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockNetworkClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// ChangeIPRange implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) ChangeIPRange(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkChangeIPRangeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeIPRange", ctx, network, opts)
+	if m.ChangeIPRangeStub == nil {
+		panic(notStubbed("MockNetworkClient.ChangeIPRange"))
+	}
+	return m.ChangeIPRangeStub(ctx, network, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) ChangeProtection(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, network, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockNetworkClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, network, opts)
+}
+
+// Create implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) Create(ctx context.Context, opts _sourceHcloud.NetworkCreateOpts) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockNetworkClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) Delete(ctx context.Context, network *_sourceHcloud.Network) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, network)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockNetworkClient.Delete"))
+	}
+	return m.DeleteStub(ctx, network)
+}
+
+// DeleteRoute implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) DeleteRoute(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkDeleteRouteOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteRoute", ctx, network, opts)
+	if m.DeleteRouteStub == nil {
+		panic(notStubbed("MockNetworkClient.DeleteRoute"))
+	}
+	return m.DeleteRouteStub(ctx, network, opts)
+}
+
+// DeleteSubnet implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) DeleteSubnet(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkDeleteSubnetOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteSubnet", ctx, network, opts)
+	if m.DeleteSubnetStub == nil {
+		panic(notStubbed("MockNetworkClient.DeleteSubnet"))
+	}
+	return m.DeleteSubnetStub(ctx, network, opts)
+}
+
+// Get implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) Get(ctx context.Context, idOrName string) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockNetworkClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) GetByID(ctx context.Context, id int64) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockNetworkClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) GetByName(ctx context.Context, name string) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockNetworkClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) Iter(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (p1 iter.Seq2[*_sourceHcloud.Network, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockNetworkClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) List(ctx context.Context, opts _sourceHcloud.NetworkListOpts) (npa1 []*_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockNetworkClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Update implements [_sourceHcloud.INetworkClient].
+func (m *MockNetworkClient) Update(ctx context.Context, network *_sourceHcloud.Network, opts _sourceHcloud.NetworkUpdateOpts) (np1 *_sourceHcloud.Network, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, network, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockNetworkClient.Update"))
+	}
+	return m.UpdateStub(ctx, network, opts)
+}
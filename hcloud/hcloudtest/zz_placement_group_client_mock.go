@@ -0,0 +1,133 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockPlacementGroupClient is a mock of [_sourceHcloud.IPlacementGroupClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockPlacementGroupClient struct {
+	recorder
+
+	// AllStub stubs [MockPlacementGroupClient.All].
+	AllStub func(ctx context.Context) (ppa1 []*_sourceHcloud.PlacementGroup, err error)
+	// AllWithOptsStub stubs [MockPlacementGroupClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (ppa1 []*_sourceHcloud.PlacementGroup, err error)
+	// CreateStub stubs [MockPlacementGroupClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.PlacementGroupCreateOpts) (p1 _sourceHcloud.PlacementGroupCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockPlacementGroupClient.Delete].
+	DeleteStub func(ctx context.Context, placementGroup *_sourceHcloud.PlacementGroup) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockPlacementGroupClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockPlacementGroupClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockPlacementGroupClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockPlacementGroupClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (p1 iter.Seq2[*_sourceHcloud.PlacementGroup, error])
+	// ListStub stubs [MockPlacementGroupClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (ppa1 []*_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockPlacementGroupClient.Update].
+	UpdateStub func(ctx context.Context, placementGroup *_sourceHcloud.PlacementGroup, opts _sourceHcloud.PlacementGroupUpdateOpts) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IPlacementGroupClient = (*MockPlacementGroupClient)(nil)
+
+// All implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) All(ctx context.Context) (ppa1 []*_sourceHcloud.PlacementGroup, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (ppa1 []*_sourceHcloud.PlacementGroup, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Create implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) Create(ctx context.Context, opts _sourceHcloud.PlacementGroupCreateOpts) (p1 _sourceHcloud.PlacementGroupCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) Delete(ctx context.Context, placementGroup *_sourceHcloud.PlacementGroup) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, placementGroup)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.Delete"))
+	}
+	return m.DeleteStub(ctx, placementGroup)
+}
+
+// Get implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) Get(ctx context.Context, idOrName string) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) GetByID(ctx context.Context, id int64) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) GetByName(ctx context.Context, name string) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) Iter(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (p1 iter.Seq2[*_sourceHcloud.PlacementGroup, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) List(ctx context.Context, opts _sourceHcloud.PlacementGroupListOpts) (ppa1 []*_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Update implements [_sourceHcloud.IPlacementGroupClient].
+func (m *MockPlacementGroupClient) Update(ctx context.Context, placementGroup *_sourceHcloud.PlacementGroup, opts _sourceHcloud.PlacementGroupUpdateOpts) (pp1 *_sourceHcloud.PlacementGroup, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, placementGroup, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockPlacementGroupClient.Update"))
+	}
+	return m.UpdateStub(ctx, placementGroup, opts)
+}
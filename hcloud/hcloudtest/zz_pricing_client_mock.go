@@ -0,0 +1,33 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockPricingClient is a mock of [_sourceHcloud.IPricingClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockPricingClient struct {
+	recorder
+
+	// GetStub stubs [MockPricingClient.Get].
+	GetStub func(ctx context.Context) (p1 _sourceHcloud.Pricing, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IPricingClient = (*MockPricingClient)(nil)
+
+// Get implements [_sourceHcloud.IPricingClient].
+func (m *MockPricingClient) Get(ctx context.Context) (p1 _sourceHcloud.Pricing, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx)
+	if m.GetStub == nil {
+		panic(notStubbed("MockPricingClient.Get"))
+	}
+	return m.GetStub(ctx)
+}
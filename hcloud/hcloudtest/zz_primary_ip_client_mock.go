@@ -0,0 +1,188 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockPrimaryIPClient is a mock of [_sourceHcloud.IPrimaryIPClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockPrimaryIPClient struct {
+	recorder
+
+	// AllStub stubs [MockPrimaryIPClient.All].
+	AllStub func(ctx context.Context) (ppa1 []*_sourceHcloud.PrimaryIP, err error)
+	// AllWithOptsStub stubs [MockPrimaryIPClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (ppa1 []*_sourceHcloud.PrimaryIP, err error)
+	// AssignStub stubs [MockPrimaryIPClient.Assign].
+	AssignStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPAssignOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeDNSPtrStub stubs [MockPrimaryIPClient.ChangeDNSPtr].
+	ChangeDNSPtrStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPChangeDNSPtrOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockPrimaryIPClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockPrimaryIPClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPCreateOpts) (pp1 *_sourceHcloud.PrimaryIPCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockPrimaryIPClient.Delete].
+	DeleteStub func(ctx context.Context, primaryIP *_sourceHcloud.PrimaryIP) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockPrimaryIPClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockPrimaryIPClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+	// GetByIPStub stubs [MockPrimaryIPClient.GetByIP].
+	GetByIPStub func(ctx context.Context, ip string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockPrimaryIPClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockPrimaryIPClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (p1 iter.Seq2[*_sourceHcloud.PrimaryIP, error])
+	// ListStub stubs [MockPrimaryIPClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (ppa1 []*_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+	// UnassignStub stubs [MockPrimaryIPClient.Unassign].
+	UnassignStub func(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockPrimaryIPClient.Update].
+	UpdateStub func(ctx context.Context, primaryIP *_sourceHcloud.PrimaryIP, opts _sourceHcloud.PrimaryIPUpdateOpts) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IPrimaryIPClient = (*MockPrimaryIPClient)(nil)
+
+// All implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) All(ctx context.Context) (ppa1 []*_sourceHcloud.PrimaryIP, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (ppa1 []*_sourceHcloud.PrimaryIP, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Assign implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Assign(ctx context.Context, opts _sourceHcloud.PrimaryIPAssignOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Assign", ctx, opts)
+	if m.AssignStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Assign"))
+	}
+	return m.AssignStub(ctx, opts)
+}
+
+// ChangeDNSPtr implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) ChangeDNSPtr(ctx context.Context, opts _sourceHcloud.PrimaryIPChangeDNSPtrOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeDNSPtr", ctx, opts)
+	if m.ChangeDNSPtrStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.ChangeDNSPtr"))
+	}
+	return m.ChangeDNSPtrStub(ctx, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) ChangeProtection(ctx context.Context, opts _sourceHcloud.PrimaryIPChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, opts)
+}
+
+// Create implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Create(ctx context.Context, opts _sourceHcloud.PrimaryIPCreateOpts) (pp1 *_sourceHcloud.PrimaryIPCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Delete(ctx context.Context, primaryIP *_sourceHcloud.PrimaryIP) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, primaryIP)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Delete"))
+	}
+	return m.DeleteStub(ctx, primaryIP)
+}
+
+// Get implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Get(ctx context.Context, idOrName string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) GetByID(ctx context.Context, id int64) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByIP implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) GetByIP(ctx context.Context, ip string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByIP", ctx, ip)
+	if m.GetByIPStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.GetByIP"))
+	}
+	return m.GetByIPStub(ctx, ip)
+}
+
+// GetByName implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) GetByName(ctx context.Context, name string) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Iter(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (p1 iter.Seq2[*_sourceHcloud.PrimaryIP, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) List(ctx context.Context, opts _sourceHcloud.PrimaryIPListOpts) (ppa1 []*_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Unassign implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Unassign(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Unassign", ctx, id)
+	if m.UnassignStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Unassign"))
+	}
+	return m.UnassignStub(ctx, id)
+}
+
+// Update implements [_sourceHcloud.IPrimaryIPClient].
+func (m *MockPrimaryIPClient) Update(ctx context.Context, primaryIP *_sourceHcloud.PrimaryIP, opts _sourceHcloud.PrimaryIPUpdateOpts) (pp1 *_sourceHcloud.PrimaryIP, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, primaryIP, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockPrimaryIPClient.Update"))
+	}
+	return m.UpdateStub(ctx, primaryIP, opts)
+}
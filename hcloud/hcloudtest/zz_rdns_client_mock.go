@@ -0,0 +1,34 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"net"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockRDNSClient is a mock of [_sourceHcloud.IRDNSClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockRDNSClient struct {
+	recorder
+
+	// ChangeDNSPtrStub stubs [MockRDNSClient.ChangeDNSPtr].
+	ChangeDNSPtrStub func(ctx context.Context, rdns _sourceHcloud.RDNSSupporter, ip net.IP, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IRDNSClient = (*MockRDNSClient)(nil)
+
+// ChangeDNSPtr implements [_sourceHcloud.IRDNSClient].
+func (m *MockRDNSClient) ChangeDNSPtr(ctx context.Context, rdns _sourceHcloud.RDNSSupporter, ip net.IP, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeDNSPtr", ctx, rdns, ip, ptr)
+	if m.ChangeDNSPtrStub == nil {
+		panic(notStubbed("MockRDNSClient.ChangeDNSPtr"))
+	}
+	return m.ChangeDNSPtrStub(ctx, rdns, ip, ptr)
+}
@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockResourceActionClient is a mock of [_sourceHcloud.IResourceActionClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockResourceActionClient[R any] struct {
+	recorder
+
+	// AllStub stubs [MockResourceActionClient.All].
+	AllStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error)
+	// AllForStub stubs [MockResourceActionClient.AllFor].
+	AllForStub func(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error)
+	// GetByIDStub stubs [MockResourceActionClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockResourceActionClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error])
+	// IterForStub stubs [MockResourceActionClient.IterFor].
+	IterForStub func(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error])
+	// ListStub stubs [MockResourceActionClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ListForStub stubs [MockResourceActionClient.ListFor].
+	ListForStub func(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IResourceActionClient[*_sourceHcloud.Server] = (*MockResourceActionClient[*_sourceHcloud.Server])(nil)
+
+// All implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) All(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error) {
+	m.record("All", ctx, opts)
+	if m.AllStub == nil {
+		panic(notStubbed("MockResourceActionClient.All"))
+	}
+	return m.AllStub(ctx, opts)
+}
+
+// AllFor implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) AllFor(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, err error) {
+	m.record("AllFor", ctx, resource, opts)
+	if m.AllForStub == nil {
+		panic(notStubbed("MockResourceActionClient.AllFor"))
+	}
+	return m.AllForStub(ctx, resource, opts)
+}
+
+// GetByID implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) GetByID(ctx context.Context, id int64) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockResourceActionClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// Iter implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) Iter(ctx context.Context, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockResourceActionClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// IterFor implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) IterFor(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (p1 iter.Seq2[*_sourceHcloud.Action, error]) {
+	m.record("IterFor", ctx, resource, opts)
+	if m.IterForStub == nil {
+		panic(notStubbed("MockResourceActionClient.IterFor"))
+	}
+	return m.IterForStub(ctx, resource, opts)
+}
+
+// List implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) List(ctx context.Context, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockResourceActionClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// ListFor implements [_sourceHcloud.IResourceActionClient].
+func (m *MockResourceActionClient[R]) ListFor(ctx context.Context, resource R, opts _sourceHcloud.ActionListOpts) (apa1 []*_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ListFor", ctx, resource, opts)
+	if m.ListForStub == nil {
+		panic(notStubbed("MockResourceActionClient.ListFor"))
+	}
+	return m.ListForStub(ctx, resource, opts)
+}
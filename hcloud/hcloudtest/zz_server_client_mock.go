@@ -0,0 +1,430 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockServerClient is a mock of [_sourceHcloud.IServerClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockServerClient struct {
+	recorder
+
+	// AddToPlacementGroupStub stubs [MockServerClient.AddToPlacementGroup].
+	AddToPlacementGroupStub func(ctx context.Context, server *_sourceHcloud.Server, placementGroup *_sourceHcloud.PlacementGroup) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AllStub stubs [MockServerClient.All].
+	AllStub func(ctx context.Context) (spa1 []*_sourceHcloud.Server, err error)
+	// AllWithOptsStub stubs [MockServerClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ServerListOpts) (spa1 []*_sourceHcloud.Server, err error)
+	// AttachISOStub stubs [MockServerClient.AttachISO].
+	AttachISOStub func(ctx context.Context, server *_sourceHcloud.Server, iso *_sourceHcloud.ISO) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AttachToNetworkStub stubs [MockServerClient.AttachToNetwork].
+	AttachToNetworkStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerAttachToNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeAliasIPsStub stubs [MockServerClient.ChangeAliasIPs].
+	ChangeAliasIPsStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeAliasIPsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeDNSPtrStub stubs [MockServerClient.ChangeDNSPtr].
+	ChangeDNSPtrStub func(ctx context.Context, server *_sourceHcloud.Server, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockServerClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeTypeStub stubs [MockServerClient.ChangeType].
+	ChangeTypeStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockServerClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.ServerCreateOpts) (s1 _sourceHcloud.ServerCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// CreateAndWaitStub stubs [MockServerClient.CreateAndWait].
+	CreateAndWaitStub func(ctx context.Context, opts _sourceHcloud.ServerCreateOpts, waitOpts _sourceHcloud.ServerCreateAndWaitOpts) (s1 _sourceHcloud.ServerCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// CreateImageStub stubs [MockServerClient.CreateImage].
+	CreateImageStub func(ctx context.Context, server *_sourceHcloud.Server, opts *_sourceHcloud.ServerCreateImageOpts) (s1 _sourceHcloud.ServerCreateImageResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockServerClient.Delete].
+	DeleteStub func(ctx context.Context, server *_sourceHcloud.Server) (rp1 *_sourceHcloud.Response, err error)
+	// DeleteWithResultStub stubs [MockServerClient.DeleteWithResult].
+	DeleteWithResultStub func(ctx context.Context, server *_sourceHcloud.Server) (sp1 *_sourceHcloud.ServerDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// DetachFromNetworkStub stubs [MockServerClient.DetachFromNetwork].
+	DetachFromNetworkStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerDetachFromNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DetachISOStub stubs [MockServerClient.DetachISO].
+	DetachISOStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DisableBackupStub stubs [MockServerClient.DisableBackup].
+	DisableBackupStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// DisableRescueStub stubs [MockServerClient.DisableRescue].
+	DisableRescueStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// EnableBackupStub stubs [MockServerClient.EnableBackup].
+	EnableBackupStub func(ctx context.Context, server *_sourceHcloud.Server, window string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// EnableRescueStub stubs [MockServerClient.EnableRescue].
+	EnableRescueStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerEnableRescueOpts) (s1 _sourceHcloud.ServerEnableRescueResult, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockServerClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockServerClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockServerClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error)
+	// GetMetricsStub stubs [MockServerClient.GetMetrics].
+	GetMetricsStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerGetMetricsOpts) (sp1 *_sourceHcloud.ServerMetrics, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockServerClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ServerListOpts) (p1 iter.Seq2[*_sourceHcloud.Server, error])
+	// ListStub stubs [MockServerClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ServerListOpts) (spa1 []*_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error)
+	// PoweroffStub stubs [MockServerClient.Poweroff].
+	PoweroffStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// PoweronStub stubs [MockServerClient.Poweron].
+	PoweronStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RebootStub stubs [MockServerClient.Reboot].
+	RebootStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RebuildStub stubs [MockServerClient.Rebuild].
+	RebuildStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerRebuildOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RebuildWithResultStub stubs [MockServerClient.RebuildWithResult].
+	RebuildWithResultStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerRebuildOpts) (s1 _sourceHcloud.ServerRebuildResult, rp1 *_sourceHcloud.Response, err error)
+	// RemoveFromPlacementGroupStub stubs [MockServerClient.RemoveFromPlacementGroup].
+	RemoveFromPlacementGroupStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RequestConsoleStub stubs [MockServerClient.RequestConsole].
+	RequestConsoleStub func(ctx context.Context, server *_sourceHcloud.Server) (s1 _sourceHcloud.ServerRequestConsoleResult, rp1 *_sourceHcloud.Response, err error)
+	// ResetStub stubs [MockServerClient.Reset].
+	ResetStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ResetPasswordStub stubs [MockServerClient.ResetPassword].
+	ResetPasswordStub func(ctx context.Context, server *_sourceHcloud.Server) (s1 _sourceHcloud.ServerResetPasswordResult, rp1 *_sourceHcloud.Response, err error)
+	// ShutdownStub stubs [MockServerClient.Shutdown].
+	ShutdownStub func(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockServerClient.Update].
+	UpdateStub func(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerUpdateOpts) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IServerClient = (*MockServerClient)(nil)
+
+// AddToPlacementGroup implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) AddToPlacementGroup(ctx context.Context, server *_sourceHcloud.Server, placementGroup *_sourceHcloud.PlacementGroup) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddToPlacementGroup", ctx, server, placementGroup)
+	if m.AddToPlacementGroupStub == nil {
+		panic(notStubbed("MockServerClient.AddToPlacementGroup"))
+	}
+	return m.AddToPlacementGroupStub(ctx, server, placementGroup)
+}
+
+// All implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) All(ctx context.Context) (spa1 []*_sourceHcloud.Server, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockServerClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ServerListOpts) (spa1 []*_sourceHcloud.Server, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockServerClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// AttachISO implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) AttachISO(ctx context.Context, server *_sourceHcloud.Server, iso *_sourceHcloud.ISO) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AttachISO", ctx, server, iso)
+	if m.AttachISOStub == nil {
+		panic(notStubbed("MockServerClient.AttachISO"))
+	}
+	return m.AttachISOStub(ctx, server, iso)
+}
+
+// AttachToNetwork implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) AttachToNetwork(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerAttachToNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AttachToNetwork", ctx, server, opts)
+	if m.AttachToNetworkStub == nil {
+		panic(notStubbed("MockServerClient.AttachToNetwork"))
+	}
+	return m.AttachToNetworkStub(ctx, server, opts)
+}
+
+// ChangeAliasIPs implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) ChangeAliasIPs(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeAliasIPsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeAliasIPs", ctx, server, opts)
+	if m.ChangeAliasIPsStub == nil {
+		panic(notStubbed("MockServerClient.ChangeAliasIPs"))
+	}
+	return m.ChangeAliasIPsStub(ctx, server, opts)
+}
+
+// ChangeDNSPtr implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) ChangeDNSPtr(ctx context.Context, server *_sourceHcloud.Server, ip string, ptr *string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeDNSPtr", ctx, server, ip, ptr)
+	if m.ChangeDNSPtrStub == nil {
+		panic(notStubbed("MockServerClient.ChangeDNSPtr"))
+	}
+	return m.ChangeDNSPtrStub(ctx, server, ip, ptr)
+}
+
+// ChangeProtection implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) ChangeProtection(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, server, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockServerClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, server, opts)
+}
+
+// ChangeType implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) ChangeType(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeType", ctx, server, opts)
+	if m.ChangeTypeStub == nil {
+		panic(notStubbed("MockServerClient.ChangeType"))
+	}
+	return m.ChangeTypeStub(ctx, server, opts)
+}
+
+// Create implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Create(ctx context.Context, opts _sourceHcloud.ServerCreateOpts) (s1 _sourceHcloud.ServerCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockServerClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// CreateAndWait implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) CreateAndWait(ctx context.Context, opts _sourceHcloud.ServerCreateOpts, waitOpts _sourceHcloud.ServerCreateAndWaitOpts) (s1 _sourceHcloud.ServerCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateAndWait", ctx, opts, waitOpts)
+	if m.CreateAndWaitStub == nil {
+		panic(notStubbed("MockServerClient.CreateAndWait"))
+	}
+	return m.CreateAndWaitStub(ctx, opts, waitOpts)
+}
+
+// CreateImage implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) CreateImage(ctx context.Context, server *_sourceHcloud.Server, opts *_sourceHcloud.ServerCreateImageOpts) (s1 _sourceHcloud.ServerCreateImageResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateImage", ctx, server, opts)
+	if m.CreateImageStub == nil {
+		panic(notStubbed("MockServerClient.CreateImage"))
+	}
+	return m.CreateImageStub(ctx, server, opts)
+}
+
+// Delete implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Delete(ctx context.Context, server *_sourceHcloud.Server) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, server)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockServerClient.Delete"))
+	}
+	return m.DeleteStub(ctx, server)
+}
+
+// DeleteWithResult implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) DeleteWithResult(ctx context.Context, server *_sourceHcloud.Server) (sp1 *_sourceHcloud.ServerDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteWithResult", ctx, server)
+	if m.DeleteWithResultStub == nil {
+		panic(notStubbed("MockServerClient.DeleteWithResult"))
+	}
+	return m.DeleteWithResultStub(ctx, server)
+}
+
+// DetachFromNetwork implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) DetachFromNetwork(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerDetachFromNetworkOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DetachFromNetwork", ctx, server, opts)
+	if m.DetachFromNetworkStub == nil {
+		panic(notStubbed("MockServerClient.DetachFromNetwork"))
+	}
+	return m.DetachFromNetworkStub(ctx, server, opts)
+}
+
+// DetachISO implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) DetachISO(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DetachISO", ctx, server)
+	if m.DetachISOStub == nil {
+		panic(notStubbed("MockServerClient.DetachISO"))
+	}
+	return m.DetachISOStub(ctx, server)
+}
+
+// DisableBackup implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) DisableBackup(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DisableBackup", ctx, server)
+	if m.DisableBackupStub == nil {
+		panic(notStubbed("MockServerClient.DisableBackup"))
+	}
+	return m.DisableBackupStub(ctx, server)
+}
+
+// DisableRescue implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) DisableRescue(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DisableRescue", ctx, server)
+	if m.DisableRescueStub == nil {
+		panic(notStubbed("MockServerClient.DisableRescue"))
+	}
+	return m.DisableRescueStub(ctx, server)
+}
+
+// EnableBackup implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) EnableBackup(ctx context.Context, server *_sourceHcloud.Server, window string) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("EnableBackup", ctx, server, window)
+	if m.EnableBackupStub == nil {
+		panic(notStubbed("MockServerClient.EnableBackup"))
+	}
+	return m.EnableBackupStub(ctx, server, window)
+}
+
+// EnableRescue implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) EnableRescue(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerEnableRescueOpts) (s1 _sourceHcloud.ServerEnableRescueResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("EnableRescue", ctx, server, opts)
+	if m.EnableRescueStub == nil {
+		panic(notStubbed("MockServerClient.EnableRescue"))
+	}
+	return m.EnableRescueStub(ctx, server, opts)
+}
+
+// Get implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Get(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockServerClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) GetByID(ctx context.Context, id int64) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockServerClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) GetByName(ctx context.Context, name string) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockServerClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// GetMetrics implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) GetMetrics(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerGetMetricsOpts) (sp1 *_sourceHcloud.ServerMetrics, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetMetrics", ctx, server, opts)
+	if m.GetMetricsStub == nil {
+		panic(notStubbed("MockServerClient.GetMetrics"))
+	}
+	return m.GetMetricsStub(ctx, server, opts)
+}
+
+// Iter implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Iter(ctx context.Context, opts _sourceHcloud.ServerListOpts) (p1 iter.Seq2[*_sourceHcloud.Server, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockServerClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) List(ctx context.Context, opts _sourceHcloud.ServerListOpts) (spa1 []*_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockServerClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Poweroff implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Poweroff(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Poweroff", ctx, server)
+	if m.PoweroffStub == nil {
+		panic(notStubbed("MockServerClient.Poweroff"))
+	}
+	return m.PoweroffStub(ctx, server)
+}
+
+// Poweron implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Poweron(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Poweron", ctx, server)
+	if m.PoweronStub == nil {
+		panic(notStubbed("MockServerClient.Poweron"))
+	}
+	return m.PoweronStub(ctx, server)
+}
+
+// Reboot implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Reboot(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Reboot", ctx, server)
+	if m.RebootStub == nil {
+		panic(notStubbed("MockServerClient.Reboot"))
+	}
+	return m.RebootStub(ctx, server)
+}
+
+// Rebuild implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Rebuild(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerRebuildOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Rebuild", ctx, server, opts)
+	if m.RebuildStub == nil {
+		panic(notStubbed("MockServerClient.Rebuild"))
+	}
+	return m.RebuildStub(ctx, server, opts)
+}
+
+// RebuildWithResult implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) RebuildWithResult(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerRebuildOpts) (s1 _sourceHcloud.ServerRebuildResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RebuildWithResult", ctx, server, opts)
+	if m.RebuildWithResultStub == nil {
+		panic(notStubbed("MockServerClient.RebuildWithResult"))
+	}
+	return m.RebuildWithResultStub(ctx, server, opts)
+}
+
+// RemoveFromPlacementGroup implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) RemoveFromPlacementGroup(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveFromPlacementGroup", ctx, server)
+	if m.RemoveFromPlacementGroupStub == nil {
+		panic(notStubbed("MockServerClient.RemoveFromPlacementGroup"))
+	}
+	return m.RemoveFromPlacementGroupStub(ctx, server)
+}
+
+// RequestConsole implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) RequestConsole(ctx context.Context, server *_sourceHcloud.Server) (s1 _sourceHcloud.ServerRequestConsoleResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RequestConsole", ctx, server)
+	if m.RequestConsoleStub == nil {
+		panic(notStubbed("MockServerClient.RequestConsole"))
+	}
+	return m.RequestConsoleStub(ctx, server)
+}
+
+// Reset implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Reset(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Reset", ctx, server)
+	if m.ResetStub == nil {
+		panic(notStubbed("MockServerClient.Reset"))
+	}
+	return m.ResetStub(ctx, server)
+}
+
+// ResetPassword implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) ResetPassword(ctx context.Context, server *_sourceHcloud.Server) (s1 _sourceHcloud.ServerResetPasswordResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ResetPassword", ctx, server)
+	if m.ResetPasswordStub == nil {
+		panic(notStubbed("MockServerClient.ResetPassword"))
+	}
+	return m.ResetPasswordStub(ctx, server)
+}
+
+// Shutdown implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Shutdown(ctx context.Context, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Shutdown", ctx, server)
+	if m.ShutdownStub == nil {
+		panic(notStubbed("MockServerClient.Shutdown"))
+	}
+	return m.ShutdownStub(ctx, server)
+}
+
+// Update implements [_sourceHcloud.IServerClient].
+func (m *MockServerClient) Update(ctx context.Context, server *_sourceHcloud.Server, opts _sourceHcloud.ServerUpdateOpts) (sp1 *_sourceHcloud.Server, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, server, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockServerClient.Update"))
+	}
+	return m.UpdateStub(ctx, server, opts)
+}
@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockServerTypeClient is a mock of [_sourceHcloud.IServerTypeClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockServerTypeClient struct {
+	recorder
+
+	// AllStub stubs [MockServerTypeClient.All].
+	AllStub func(ctx context.Context) (spa1 []*_sourceHcloud.ServerType, err error)
+	// AllWithOptsStub stubs [MockServerTypeClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (spa1 []*_sourceHcloud.ServerType, err error)
+	// GetStub stubs [MockServerTypeClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockServerTypeClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockServerTypeClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockServerTypeClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.ServerType, error])
+	// ListStub stubs [MockServerTypeClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (spa1 []*_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IServerTypeClient = (*MockServerTypeClient)(nil)
+
+// All implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) All(ctx context.Context) (spa1 []*_sourceHcloud.ServerType, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockServerTypeClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (spa1 []*_sourceHcloud.ServerType, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockServerTypeClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) Get(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockServerTypeClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) GetByID(ctx context.Context, id int64) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockServerTypeClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) GetByName(ctx context.Context, name string) (sp1 *_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockServerTypeClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) Iter(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.ServerType, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockServerTypeClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IServerTypeClient].
+func (m *MockServerTypeClient) List(ctx context.Context, opts _sourceHcloud.ServerTypeListOpts) (spa1 []*_sourceHcloud.ServerType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockServerTypeClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
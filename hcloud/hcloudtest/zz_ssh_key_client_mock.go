@@ -0,0 +1,144 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockSSHKeyClient is a mock of [_sourceHcloud.ISSHKeyClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockSSHKeyClient struct {
+	recorder
+
+	// AllStub stubs [MockSSHKeyClient.All].
+	AllStub func(ctx context.Context) (spa1 []*_sourceHcloud.SSHKey, err error)
+	// AllWithOptsStub stubs [MockSSHKeyClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (spa1 []*_sourceHcloud.SSHKey, err error)
+	// CreateStub stubs [MockSSHKeyClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.SSHKeyCreateOpts) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockSSHKeyClient.Delete].
+	DeleteStub func(ctx context.Context, sshKey *_sourceHcloud.SSHKey) (rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockSSHKeyClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// GetByFingerprintStub stubs [MockSSHKeyClient.GetByFingerprint].
+	GetByFingerprintStub func(ctx context.Context, fingerprint string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockSSHKeyClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockSSHKeyClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockSSHKeyClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (p1 iter.Seq2[*_sourceHcloud.SSHKey, error])
+	// ListStub stubs [MockSSHKeyClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (spa1 []*_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockSSHKeyClient.Update].
+	UpdateStub func(ctx context.Context, sshKey *_sourceHcloud.SSHKey, opts _sourceHcloud.SSHKeyUpdateOpts) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.ISSHKeyClient = (*MockSSHKeyClient)(nil)
+
+// All implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) All(ctx context.Context) (spa1 []*_sourceHcloud.SSHKey, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockSSHKeyClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (spa1 []*_sourceHcloud.SSHKey, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockSSHKeyClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Create implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) Create(ctx context.Context, opts _sourceHcloud.SSHKeyCreateOpts) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockSSHKeyClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) Delete(ctx context.Context, sshKey *_sourceHcloud.SSHKey) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, sshKey)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockSSHKeyClient.Delete"))
+	}
+	return m.DeleteStub(ctx, sshKey)
+}
+
+// Get implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) Get(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockSSHKeyClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByFingerprint implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) GetByFingerprint(ctx context.Context, fingerprint string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByFingerprint", ctx, fingerprint)
+	if m.GetByFingerprintStub == nil {
+		panic(notStubbed("MockSSHKeyClient.GetByFingerprint"))
+	}
+	return m.GetByFingerprintStub(ctx, fingerprint)
+}
+
+// GetByID implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) GetByID(ctx context.Context, id int64) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockSSHKeyClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) GetByName(ctx context.Context, name string) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockSSHKeyClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) Iter(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (p1 iter.Seq2[*_sourceHcloud.SSHKey, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockSSHKeyClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) List(ctx context.Context, opts _sourceHcloud.SSHKeyListOpts) (spa1 []*_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockSSHKeyClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Update implements [_sourceHcloud.ISSHKeyClient].
+func (m *MockSSHKeyClient) Update(ctx context.Context, sshKey *_sourceHcloud.SSHKey, opts _sourceHcloud.SSHKeyUpdateOpts) (sp1 *_sourceHcloud.SSHKey, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, sshKey, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockSSHKeyClient.Update"))
+	}
+	return m.UpdateStub(ctx, sshKey, opts)
+}
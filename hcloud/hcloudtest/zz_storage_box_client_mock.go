@@ -0,0 +1,485 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockStorageBoxClient is a mock of [_sourceHcloud.IStorageBoxClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockStorageBoxClient struct {
+	recorder
+
+	// AllStub stubs [MockStorageBoxClient.All].
+	AllStub func(ctx context.Context) (spa1 []*_sourceHcloud.StorageBox, err error)
+	// AllSnapshotsStub stubs [MockStorageBoxClient.AllSnapshots].
+	AllSnapshotsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (spa1 []*_sourceHcloud.StorageBoxSnapshot, err error)
+	// AllSnapshotsWithOptsStub stubs [MockStorageBoxClient.AllSnapshotsWithOpts].
+	AllSnapshotsWithOptsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (spa1 []*_sourceHcloud.StorageBoxSnapshot, err error)
+	// AllSubaccountsStub stubs [MockStorageBoxClient.AllSubaccounts].
+	AllSubaccountsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (spa1 []*_sourceHcloud.StorageBoxSubaccount, err error)
+	// AllSubaccountsWithOptsStub stubs [MockStorageBoxClient.AllSubaccountsWithOpts].
+	AllSubaccountsWithOptsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (spa1 []*_sourceHcloud.StorageBoxSubaccount, err error)
+	// AllWithOptsStub stubs [MockStorageBoxClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (spa1 []*_sourceHcloud.StorageBox, err error)
+	// ChangeProtectionStub stubs [MockStorageBoxClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeSubaccountHomeDirectoryStub stubs [MockStorageBoxClient.ChangeSubaccountHomeDirectory].
+	ChangeSubaccountHomeDirectoryStub func(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountChangeHomeDirectoryOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeTypeStub stubs [MockStorageBoxClient.ChangeType].
+	ChangeTypeStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockStorageBoxClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.StorageBoxCreateOpts) (s1 _sourceHcloud.StorageBoxCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// CreateSnapshotStub stubs [MockStorageBoxClient.CreateSnapshot].
+	CreateSnapshotStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotCreateOpts) (s1 _sourceHcloud.StorageBoxSnapshotCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// CreateSubaccountStub stubs [MockStorageBoxClient.CreateSubaccount].
+	CreateSubaccountStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountCreateOpts) (s1 _sourceHcloud.StorageBoxSubaccountCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockStorageBoxClient.Delete].
+	DeleteStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (s1 _sourceHcloud.StorageBoxDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteSnapshotStub stubs [MockStorageBoxClient.DeleteSnapshot].
+	DeleteSnapshotStub func(ctx context.Context, snapshot *_sourceHcloud.StorageBoxSnapshot) (s1 _sourceHcloud.StorageBoxSnapshotDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteSubaccountStub stubs [MockStorageBoxClient.DeleteSubaccount].
+	DeleteSubaccountStub func(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount) (s1 _sourceHcloud.StorageBoxSubaccountDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// DisableSnapshotPlanStub stubs [MockStorageBoxClient.DisableSnapshotPlan].
+	DisableSnapshotPlanStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// EnableSnapshotPlanStub stubs [MockStorageBoxClient.EnableSnapshotPlan].
+	EnableSnapshotPlanStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxEnableSnapshotPlanOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// FoldersStub stubs [MockStorageBoxClient.Folders].
+	FoldersStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxFoldersOpts) (s1 _sourceHcloud.StorageBoxFoldersResult, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockStorageBoxClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockStorageBoxClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockStorageBoxClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error)
+	// GetSnapshotStub stubs [MockStorageBoxClient.GetSnapshot].
+	GetSnapshotStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, idOrName string) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error)
+	// GetSnapshotByIDStub stubs [MockStorageBoxClient.GetSnapshotByID].
+	GetSnapshotByIDStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, id int64) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error)
+	// GetSnapshotByNameStub stubs [MockStorageBoxClient.GetSnapshotByName].
+	GetSnapshotByNameStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, name string) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error)
+	// GetSubaccountStub stubs [MockStorageBoxClient.GetSubaccount].
+	GetSubaccountStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, idOrName string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// GetSubaccountByIDStub stubs [MockStorageBoxClient.GetSubaccountByID].
+	GetSubaccountByIDStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, id int64) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// GetSubaccountByNameStub stubs [MockStorageBoxClient.GetSubaccountByName].
+	GetSubaccountByNameStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, name string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// GetSubaccountByUsernameStub stubs [MockStorageBoxClient.GetSubaccountByUsername].
+	GetSubaccountByUsernameStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, username string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockStorageBoxClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBox, error])
+	// IterSnapshotsStub stubs [MockStorageBoxClient.IterSnapshots].
+	IterSnapshotsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxSnapshot, error])
+	// IterSubaccountsStub stubs [MockStorageBoxClient.IterSubaccounts].
+	IterSubaccountsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxSubaccount, error])
+	// ListStub stubs [MockStorageBoxClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (spa1 []*_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error)
+	// ListSnapshotsStub stubs [MockStorageBoxClient.ListSnapshots].
+	ListSnapshotsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (spa1 []*_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error)
+	// ListSubaccountsStub stubs [MockStorageBoxClient.ListSubaccounts].
+	ListSubaccountsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (spa1 []*_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// ResetPasswordStub stubs [MockStorageBoxClient.ResetPassword].
+	ResetPasswordStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxResetPasswordOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ResetSubaccountPasswordStub stubs [MockStorageBoxClient.ResetSubaccountPassword].
+	ResetSubaccountPasswordStub func(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountResetPasswordOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// RollbackSnapshotStub stubs [MockStorageBoxClient.RollbackSnapshot].
+	RollbackSnapshotStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxRollbackSnapshotOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockStorageBoxClient.Update].
+	UpdateStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxUpdateOpts) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error)
+	// UpdateAccessSettingsStub stubs [MockStorageBoxClient.UpdateAccessSettings].
+	UpdateAccessSettingsStub func(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxUpdateAccessSettingsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateSnapshotStub stubs [MockStorageBoxClient.UpdateSnapshot].
+	UpdateSnapshotStub func(ctx context.Context, snapshot *_sourceHcloud.StorageBoxSnapshot, opts _sourceHcloud.StorageBoxSnapshotUpdateOpts) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error)
+	// UpdateSubaccountStub stubs [MockStorageBoxClient.UpdateSubaccount].
+	UpdateSubaccountStub func(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountUpdateOpts) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error)
+	// UpdateSubaccountAccessSettingsStub stubs [MockStorageBoxClient.UpdateSubaccountAccessSettings].
+	UpdateSubaccountAccessSettingsStub func(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountUpdateAccessSettingsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IStorageBoxClient = (*MockStorageBoxClient)(nil)
+
+// All implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) All(ctx context.Context) (spa1 []*_sourceHcloud.StorageBox, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockStorageBoxClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllSnapshots implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) AllSnapshots(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (spa1 []*_sourceHcloud.StorageBoxSnapshot, err error) {
+	m.record("AllSnapshots", ctx, storageBox)
+	if m.AllSnapshotsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.AllSnapshots"))
+	}
+	return m.AllSnapshotsStub(ctx, storageBox)
+}
+
+// AllSnapshotsWithOpts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) AllSnapshotsWithOpts(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (spa1 []*_sourceHcloud.StorageBoxSnapshot, err error) {
+	m.record("AllSnapshotsWithOpts", ctx, storageBox, opts)
+	if m.AllSnapshotsWithOptsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.AllSnapshotsWithOpts"))
+	}
+	return m.AllSnapshotsWithOptsStub(ctx, storageBox, opts)
+}
+
+// AllSubaccounts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) AllSubaccounts(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (spa1 []*_sourceHcloud.StorageBoxSubaccount, err error) {
+	m.record("AllSubaccounts", ctx, storageBox)
+	if m.AllSubaccountsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.AllSubaccounts"))
+	}
+	return m.AllSubaccountsStub(ctx, storageBox)
+}
+
+// AllSubaccountsWithOpts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) AllSubaccountsWithOpts(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (spa1 []*_sourceHcloud.StorageBoxSubaccount, err error) {
+	m.record("AllSubaccountsWithOpts", ctx, storageBox, opts)
+	if m.AllSubaccountsWithOptsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.AllSubaccountsWithOpts"))
+	}
+	return m.AllSubaccountsWithOptsStub(ctx, storageBox, opts)
+}
+
+// AllWithOpts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (spa1 []*_sourceHcloud.StorageBox, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ChangeProtection(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, storageBox, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, storageBox, opts)
+}
+
+// ChangeSubaccountHomeDirectory implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ChangeSubaccountHomeDirectory(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountChangeHomeDirectoryOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeSubaccountHomeDirectory", ctx, subaccount, opts)
+	if m.ChangeSubaccountHomeDirectoryStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ChangeSubaccountHomeDirectory"))
+	}
+	return m.ChangeSubaccountHomeDirectoryStub(ctx, subaccount, opts)
+}
+
+// ChangeType implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ChangeType(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxChangeTypeOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeType", ctx, storageBox, opts)
+	if m.ChangeTypeStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ChangeType"))
+	}
+	return m.ChangeTypeStub(ctx, storageBox, opts)
+}
+
+// Create implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Create(ctx context.Context, opts _sourceHcloud.StorageBoxCreateOpts) (s1 _sourceHcloud.StorageBoxCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// CreateSnapshot implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) CreateSnapshot(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotCreateOpts) (s1 _sourceHcloud.StorageBoxSnapshotCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateSnapshot", ctx, storageBox, opts)
+	if m.CreateSnapshotStub == nil {
+		panic(notStubbed("MockStorageBoxClient.CreateSnapshot"))
+	}
+	return m.CreateSnapshotStub(ctx, storageBox, opts)
+}
+
+// CreateSubaccount implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) CreateSubaccount(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountCreateOpts) (s1 _sourceHcloud.StorageBoxSubaccountCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateSubaccount", ctx, storageBox, opts)
+	if m.CreateSubaccountStub == nil {
+		panic(notStubbed("MockStorageBoxClient.CreateSubaccount"))
+	}
+	return m.CreateSubaccountStub(ctx, storageBox, opts)
+}
+
+// Delete implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Delete(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (s1 _sourceHcloud.StorageBoxDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, storageBox)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Delete"))
+	}
+	return m.DeleteStub(ctx, storageBox)
+}
+
+// DeleteSnapshot implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) DeleteSnapshot(ctx context.Context, snapshot *_sourceHcloud.StorageBoxSnapshot) (s1 _sourceHcloud.StorageBoxSnapshotDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteSnapshot", ctx, snapshot)
+	if m.DeleteSnapshotStub == nil {
+		panic(notStubbed("MockStorageBoxClient.DeleteSnapshot"))
+	}
+	return m.DeleteSnapshotStub(ctx, snapshot)
+}
+
+// DeleteSubaccount implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) DeleteSubaccount(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount) (s1 _sourceHcloud.StorageBoxSubaccountDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteSubaccount", ctx, subaccount)
+	if m.DeleteSubaccountStub == nil {
+		panic(notStubbed("MockStorageBoxClient.DeleteSubaccount"))
+	}
+	return m.DeleteSubaccountStub(ctx, subaccount)
+}
+
+// DisableSnapshotPlan implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) DisableSnapshotPlan(ctx context.Context, storageBox *_sourceHcloud.StorageBox) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DisableSnapshotPlan", ctx, storageBox)
+	if m.DisableSnapshotPlanStub == nil {
+		panic(notStubbed("MockStorageBoxClient.DisableSnapshotPlan"))
+	}
+	return m.DisableSnapshotPlanStub(ctx, storageBox)
+}
+
+// EnableSnapshotPlan implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) EnableSnapshotPlan(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxEnableSnapshotPlanOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("EnableSnapshotPlan", ctx, storageBox, opts)
+	if m.EnableSnapshotPlanStub == nil {
+		panic(notStubbed("MockStorageBoxClient.EnableSnapshotPlan"))
+	}
+	return m.EnableSnapshotPlanStub(ctx, storageBox, opts)
+}
+
+// Folders implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Folders(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxFoldersOpts) (s1 _sourceHcloud.StorageBoxFoldersResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Folders", ctx, storageBox, opts)
+	if m.FoldersStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Folders"))
+	}
+	return m.FoldersStub(ctx, storageBox, opts)
+}
+
+// Get implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Get(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetByID(ctx context.Context, id int64) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetByName(ctx context.Context, name string) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// GetSnapshot implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSnapshot(ctx context.Context, storageBox *_sourceHcloud.StorageBox, idOrName string) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSnapshot", ctx, storageBox, idOrName)
+	if m.GetSnapshotStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSnapshot"))
+	}
+	return m.GetSnapshotStub(ctx, storageBox, idOrName)
+}
+
+// GetSnapshotByID implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSnapshotByID(ctx context.Context, storageBox *_sourceHcloud.StorageBox, id int64) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSnapshotByID", ctx, storageBox, id)
+	if m.GetSnapshotByIDStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSnapshotByID"))
+	}
+	return m.GetSnapshotByIDStub(ctx, storageBox, id)
+}
+
+// GetSnapshotByName implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSnapshotByName(ctx context.Context, storageBox *_sourceHcloud.StorageBox, name string) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSnapshotByName", ctx, storageBox, name)
+	if m.GetSnapshotByNameStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSnapshotByName"))
+	}
+	return m.GetSnapshotByNameStub(ctx, storageBox, name)
+}
+
+// GetSubaccount implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSubaccount(ctx context.Context, storageBox *_sourceHcloud.StorageBox, idOrName string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSubaccount", ctx, storageBox, idOrName)
+	if m.GetSubaccountStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSubaccount"))
+	}
+	return m.GetSubaccountStub(ctx, storageBox, idOrName)
+}
+
+// GetSubaccountByID implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSubaccountByID(ctx context.Context, storageBox *_sourceHcloud.StorageBox, id int64) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSubaccountByID", ctx, storageBox, id)
+	if m.GetSubaccountByIDStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSubaccountByID"))
+	}
+	return m.GetSubaccountByIDStub(ctx, storageBox, id)
+}
+
+// GetSubaccountByName implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSubaccountByName(ctx context.Context, storageBox *_sourceHcloud.StorageBox, name string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSubaccountByName", ctx, storageBox, name)
+	if m.GetSubaccountByNameStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSubaccountByName"))
+	}
+	return m.GetSubaccountByNameStub(ctx, storageBox, name)
+}
+
+// GetSubaccountByUsername implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) GetSubaccountByUsername(ctx context.Context, storageBox *_sourceHcloud.StorageBox, username string) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetSubaccountByUsername", ctx, storageBox, username)
+	if m.GetSubaccountByUsernameStub == nil {
+		panic(notStubbed("MockStorageBoxClient.GetSubaccountByUsername"))
+	}
+	return m.GetSubaccountByUsernameStub(ctx, storageBox, username)
+}
+
+// Iter implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Iter(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBox, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// IterSnapshots implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) IterSnapshots(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxSnapshot, error]) {
+	m.record("IterSnapshots", ctx, storageBox, opts)
+	if m.IterSnapshotsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.IterSnapshots"))
+	}
+	return m.IterSnapshotsStub(ctx, storageBox, opts)
+}
+
+// IterSubaccounts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) IterSubaccounts(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxSubaccount, error]) {
+	m.record("IterSubaccounts", ctx, storageBox, opts)
+	if m.IterSubaccountsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.IterSubaccounts"))
+	}
+	return m.IterSubaccountsStub(ctx, storageBox, opts)
+}
+
+// List implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) List(ctx context.Context, opts _sourceHcloud.StorageBoxListOpts) (spa1 []*_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockStorageBoxClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// ListSnapshots implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ListSnapshots(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSnapshotListOpts) (spa1 []*_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ListSnapshots", ctx, storageBox, opts)
+	if m.ListSnapshotsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ListSnapshots"))
+	}
+	return m.ListSnapshotsStub(ctx, storageBox, opts)
+}
+
+// ListSubaccounts implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ListSubaccounts(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxSubaccountListOpts) (spa1 []*_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ListSubaccounts", ctx, storageBox, opts)
+	if m.ListSubaccountsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ListSubaccounts"))
+	}
+	return m.ListSubaccountsStub(ctx, storageBox, opts)
+}
+
+// ResetPassword implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ResetPassword(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxResetPasswordOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ResetPassword", ctx, storageBox, opts)
+	if m.ResetPasswordStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ResetPassword"))
+	}
+	return m.ResetPasswordStub(ctx, storageBox, opts)
+}
+
+// ResetSubaccountPassword implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) ResetSubaccountPassword(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountResetPasswordOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ResetSubaccountPassword", ctx, subaccount, opts)
+	if m.ResetSubaccountPasswordStub == nil {
+		panic(notStubbed("MockStorageBoxClient.ResetSubaccountPassword"))
+	}
+	return m.ResetSubaccountPasswordStub(ctx, subaccount, opts)
+}
+
+// RollbackSnapshot implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) RollbackSnapshot(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxRollbackSnapshotOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RollbackSnapshot", ctx, storageBox, opts)
+	if m.RollbackSnapshotStub == nil {
+		panic(notStubbed("MockStorageBoxClient.RollbackSnapshot"))
+	}
+	return m.RollbackSnapshotStub(ctx, storageBox, opts)
+}
+
+// Update implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) Update(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxUpdateOpts) (sp1 *_sourceHcloud.StorageBox, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, storageBox, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockStorageBoxClient.Update"))
+	}
+	return m.UpdateStub(ctx, storageBox, opts)
+}
+
+// UpdateAccessSettings implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) UpdateAccessSettings(ctx context.Context, storageBox *_sourceHcloud.StorageBox, opts _sourceHcloud.StorageBoxUpdateAccessSettingsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateAccessSettings", ctx, storageBox, opts)
+	if m.UpdateAccessSettingsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.UpdateAccessSettings"))
+	}
+	return m.UpdateAccessSettingsStub(ctx, storageBox, opts)
+}
+
+// UpdateSnapshot implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) UpdateSnapshot(ctx context.Context, snapshot *_sourceHcloud.StorageBoxSnapshot, opts _sourceHcloud.StorageBoxSnapshotUpdateOpts) (sp1 *_sourceHcloud.StorageBoxSnapshot, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateSnapshot", ctx, snapshot, opts)
+	if m.UpdateSnapshotStub == nil {
+		panic(notStubbed("MockStorageBoxClient.UpdateSnapshot"))
+	}
+	return m.UpdateSnapshotStub(ctx, snapshot, opts)
+}
+
+// UpdateSubaccount implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) UpdateSubaccount(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountUpdateOpts) (sp1 *_sourceHcloud.StorageBoxSubaccount, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateSubaccount", ctx, subaccount, opts)
+	if m.UpdateSubaccountStub == nil {
+		panic(notStubbed("MockStorageBoxClient.UpdateSubaccount"))
+	}
+	return m.UpdateSubaccountStub(ctx, subaccount, opts)
+}
+
+// UpdateSubaccountAccessSettings implements [_sourceHcloud.IStorageBoxClient].
+func (m *MockStorageBoxClient) UpdateSubaccountAccessSettings(ctx context.Context, subaccount *_sourceHcloud.StorageBoxSubaccount, opts _sourceHcloud.StorageBoxSubaccountUpdateAccessSettingsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateSubaccountAccessSettings", ctx, subaccount, opts)
+	if m.UpdateSubaccountAccessSettingsStub == nil {
+		panic(notStubbed("MockStorageBoxClient.UpdateSubaccountAccessSettings"))
+	}
+	return m.UpdateSubaccountAccessSettingsStub(ctx, subaccount, opts)
+}
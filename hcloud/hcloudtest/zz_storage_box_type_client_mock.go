@@ -0,0 +1,100 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockStorageBoxTypeClient is a mock of [_sourceHcloud.IStorageBoxTypeClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockStorageBoxTypeClient struct {
+	recorder
+
+	// AllStub stubs [MockStorageBoxTypeClient.All].
+	AllStub func(ctx context.Context) (spa1 []*_sourceHcloud.StorageBoxType, err error)
+	// AllWithOptsStub stubs [MockStorageBoxTypeClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (spa1 []*_sourceHcloud.StorageBoxType, err error)
+	// GetStub stubs [MockStorageBoxTypeClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockStorageBoxTypeClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockStorageBoxTypeClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockStorageBoxTypeClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxType, error])
+	// ListStub stubs [MockStorageBoxTypeClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (spa1 []*_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IStorageBoxTypeClient = (*MockStorageBoxTypeClient)(nil)
+
+// All implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) All(ctx context.Context) (spa1 []*_sourceHcloud.StorageBoxType, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (spa1 []*_sourceHcloud.StorageBoxType, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Get implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) Get(ctx context.Context, idOrName string) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) GetByID(ctx context.Context, id int64) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) GetByName(ctx context.Context, name string) (sp1 *_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) Iter(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (p1 iter.Seq2[*_sourceHcloud.StorageBoxType, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IStorageBoxTypeClient].
+func (m *MockStorageBoxTypeClient) List(ctx context.Context, opts _sourceHcloud.StorageBoxTypeListOpts) (spa1 []*_sourceHcloud.StorageBoxType, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockStorageBoxTypeClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
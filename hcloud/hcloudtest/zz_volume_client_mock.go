@@ -0,0 +1,188 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockVolumeClient is a mock of [_sourceHcloud.IVolumeClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockVolumeClient struct {
+	recorder
+
+	// AllStub stubs [MockVolumeClient.All].
+	AllStub func(ctx context.Context) (vpa1 []*_sourceHcloud.Volume, err error)
+	// AllWithOptsStub stubs [MockVolumeClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (vpa1 []*_sourceHcloud.Volume, err error)
+	// AttachStub stubs [MockVolumeClient.Attach].
+	AttachStub func(ctx context.Context, volume *_sourceHcloud.Volume, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AttachWithOptsStub stubs [MockVolumeClient.AttachWithOpts].
+	AttachWithOptsStub func(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeAttachOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockVolumeClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockVolumeClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.VolumeCreateOpts) (v1 _sourceHcloud.VolumeCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockVolumeClient.Delete].
+	DeleteStub func(ctx context.Context, volume *_sourceHcloud.Volume) (rp1 *_sourceHcloud.Response, err error)
+	// DetachStub stubs [MockVolumeClient.Detach].
+	DetachStub func(ctx context.Context, volume *_sourceHcloud.Volume) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockVolumeClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockVolumeClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockVolumeClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockVolumeClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (p1 iter.Seq2[*_sourceHcloud.Volume, error])
+	// ListStub stubs [MockVolumeClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (vpa1 []*_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error)
+	// ResizeStub stubs [MockVolumeClient.Resize].
+	ResizeStub func(ctx context.Context, volume *_sourceHcloud.Volume, size int) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockVolumeClient.Update].
+	UpdateStub func(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeUpdateOpts) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IVolumeClient = (*MockVolumeClient)(nil)
+
+// All implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) All(ctx context.Context) (vpa1 []*_sourceHcloud.Volume, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockVolumeClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllWithOpts implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (vpa1 []*_sourceHcloud.Volume, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockVolumeClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// Attach implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Attach(ctx context.Context, volume *_sourceHcloud.Volume, server *_sourceHcloud.Server) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Attach", ctx, volume, server)
+	if m.AttachStub == nil {
+		panic(notStubbed("MockVolumeClient.Attach"))
+	}
+	return m.AttachStub(ctx, volume, server)
+}
+
+// AttachWithOpts implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) AttachWithOpts(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeAttachOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AttachWithOpts", ctx, volume, opts)
+	if m.AttachWithOptsStub == nil {
+		panic(notStubbed("MockVolumeClient.AttachWithOpts"))
+	}
+	return m.AttachWithOptsStub(ctx, volume, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) ChangeProtection(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, volume, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockVolumeClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, volume, opts)
+}
+
+// Create implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Create(ctx context.Context, opts _sourceHcloud.VolumeCreateOpts) (v1 _sourceHcloud.VolumeCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockVolumeClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// Delete implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Delete(ctx context.Context, volume *_sourceHcloud.Volume) (rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, volume)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockVolumeClient.Delete"))
+	}
+	return m.DeleteStub(ctx, volume)
+}
+
+// Detach implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Detach(ctx context.Context, volume *_sourceHcloud.Volume) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Detach", ctx, volume)
+	if m.DetachStub == nil {
+		panic(notStubbed("MockVolumeClient.Detach"))
+	}
+	return m.DetachStub(ctx, volume)
+}
+
+// Get implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Get(ctx context.Context, idOrName string) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockVolumeClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) GetByID(ctx context.Context, id int64) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockVolumeClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) GetByName(ctx context.Context, name string) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockVolumeClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// Iter implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Iter(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (p1 iter.Seq2[*_sourceHcloud.Volume, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockVolumeClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// List implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) List(ctx context.Context, opts _sourceHcloud.VolumeListOpts) (vpa1 []*_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockVolumeClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// Resize implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Resize(ctx context.Context, volume *_sourceHcloud.Volume, size int) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Resize", ctx, volume, size)
+	if m.ResizeStub == nil {
+		panic(notStubbed("MockVolumeClient.Resize"))
+	}
+	return m.ResizeStub(ctx, volume, size)
+}
+
+// Update implements [_sourceHcloud.IVolumeClient].
+func (m *MockVolumeClient) Update(ctx context.Context, volume *_sourceHcloud.Volume, opts _sourceHcloud.VolumeUpdateOpts) (vp1 *_sourceHcloud.Volume, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, volume, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockVolumeClient.Update"))
+	}
+	return m.UpdateStub(ctx, volume, opts)
+}
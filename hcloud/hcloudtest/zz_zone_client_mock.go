@@ -0,0 +1,353 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package hcloudtest
+
+import (
+	"context"
+	"iter"
+
+	_sourceHcloud "github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// MockZoneClient is a mock of [_sourceHcloud.IZoneClient].
+//
+// Each method records its call, and calls the stub function of the same name with a
+// Stub suffix. Calling a method without stub function panics.
+type MockZoneClient struct {
+	recorder
+
+	// AddRRSetRecordsStub stubs [MockZoneClient.AddRRSetRecords].
+	AddRRSetRecordsStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetAddRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// AllStub stubs [MockZoneClient.All].
+	AllStub func(ctx context.Context) (zpa1 []*_sourceHcloud.Zone, err error)
+	// AllRRSetsStub stubs [MockZoneClient.AllRRSets].
+	AllRRSetsStub func(ctx context.Context, zone *_sourceHcloud.Zone) (zpa1 []*_sourceHcloud.ZoneRRSet, err error)
+	// AllRRSetsWithOptsStub stubs [MockZoneClient.AllRRSetsWithOpts].
+	AllRRSetsWithOptsStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (zpa1 []*_sourceHcloud.ZoneRRSet, err error)
+	// AllWithOptsStub stubs [MockZoneClient.AllWithOpts].
+	AllWithOptsStub func(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (zpa1 []*_sourceHcloud.Zone, err error)
+	// ChangePrimaryNameserversStub stubs [MockZoneClient.ChangePrimaryNameservers].
+	ChangePrimaryNameserversStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangePrimaryNameserversOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeProtectionStub stubs [MockZoneClient.ChangeProtection].
+	ChangeProtectionStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeRRSetProtectionStub stubs [MockZoneClient.ChangeRRSetProtection].
+	ChangeRRSetProtectionStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeRRSetTTLStub stubs [MockZoneClient.ChangeRRSetTTL].
+	ChangeRRSetTTLStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetChangeTTLOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// ChangeTTLStub stubs [MockZoneClient.ChangeTTL].
+	ChangeTTLStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangeTTLOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// CreateStub stubs [MockZoneClient.Create].
+	CreateStub func(ctx context.Context, opts _sourceHcloud.ZoneCreateOpts) (z1 _sourceHcloud.ZoneCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// CreateRRSetStub stubs [MockZoneClient.CreateRRSet].
+	CreateRRSetStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetCreateOpts) (z1 _sourceHcloud.ZoneRRSetCreateResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteStub stubs [MockZoneClient.Delete].
+	DeleteStub func(ctx context.Context, zone *_sourceHcloud.Zone) (z1 _sourceHcloud.ZoneDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// DeleteRRSetStub stubs [MockZoneClient.DeleteRRSet].
+	DeleteRRSetStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet) (z1 _sourceHcloud.ZoneRRSetDeleteResult, rp1 *_sourceHcloud.Response, err error)
+	// ExportZonefileStub stubs [MockZoneClient.ExportZonefile].
+	ExportZonefileStub func(ctx context.Context, zone *_sourceHcloud.Zone) (z1 _sourceHcloud.ZoneExportZonefileResult, rp1 *_sourceHcloud.Response, err error)
+	// GetStub stubs [MockZoneClient.Get].
+	GetStub func(ctx context.Context, idOrName string) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error)
+	// GetByIDStub stubs [MockZoneClient.GetByID].
+	GetByIDStub func(ctx context.Context, id int64) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error)
+	// GetByNameStub stubs [MockZoneClient.GetByName].
+	GetByNameStub func(ctx context.Context, name string) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error)
+	// GetRRSetByIDStub stubs [MockZoneClient.GetRRSetByID].
+	GetRRSetByIDStub func(ctx context.Context, zone *_sourceHcloud.Zone, rrsetID string) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error)
+	// GetRRSetByNameAndTypeStub stubs [MockZoneClient.GetRRSetByNameAndType].
+	GetRRSetByNameAndTypeStub func(ctx context.Context, zone *_sourceHcloud.Zone, rrsetName string, rrsetType _sourceHcloud.ZoneRRSetType) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error)
+	// ImportZonefileStub stubs [MockZoneClient.ImportZonefile].
+	ImportZonefileStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneImportZonefileOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// IterStub stubs [MockZoneClient.Iter].
+	IterStub func(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (p1 iter.Seq2[*_sourceHcloud.Zone, error])
+	// IterRRSetsStub stubs [MockZoneClient.IterRRSets].
+	IterRRSetsStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (p1 iter.Seq2[*_sourceHcloud.ZoneRRSet, error])
+	// ListStub stubs [MockZoneClient.List].
+	ListStub func(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (zpa1 []*_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error)
+	// ListRRSetsStub stubs [MockZoneClient.ListRRSets].
+	ListRRSetsStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (zpa1 []*_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error)
+	// RemoveRRSetRecordsStub stubs [MockZoneClient.RemoveRRSetRecords].
+	RemoveRRSetRecordsStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetRemoveRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// SetRRSetRecordsStub stubs [MockZoneClient.SetRRSetRecords].
+	SetRRSetRecordsStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetSetRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+	// UpdateStub stubs [MockZoneClient.Update].
+	UpdateStub func(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneUpdateOpts) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error)
+	// UpdateRRSetStub stubs [MockZoneClient.UpdateRRSet].
+	UpdateRRSetStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetUpdateOpts) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error)
+	// UpdateRRSetRecordsStub stubs [MockZoneClient.UpdateRRSetRecords].
+	UpdateRRSetRecordsStub func(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetUpdateRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error)
+}
+
+var _ _sourceHcloud.IZoneClient = (*MockZoneClient)(nil)
+
+// AddRRSetRecords implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) AddRRSetRecords(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetAddRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("AddRRSetRecords", ctx, rrset, opts)
+	if m.AddRRSetRecordsStub == nil {
+		panic(notStubbed("MockZoneClient.AddRRSetRecords"))
+	}
+	return m.AddRRSetRecordsStub(ctx, rrset, opts)
+}
+
+// All implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) All(ctx context.Context) (zpa1 []*_sourceHcloud.Zone, err error) {
+	m.record("All", ctx)
+	if m.AllStub == nil {
+		panic(notStubbed("MockZoneClient.All"))
+	}
+	return m.AllStub(ctx)
+}
+
+// AllRRSets implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) AllRRSets(ctx context.Context, zone *_sourceHcloud.Zone) (zpa1 []*_sourceHcloud.ZoneRRSet, err error) {
+	m.record("AllRRSets", ctx, zone)
+	if m.AllRRSetsStub == nil {
+		panic(notStubbed("MockZoneClient.AllRRSets"))
+	}
+	return m.AllRRSetsStub(ctx, zone)
+}
+
+// AllRRSetsWithOpts implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) AllRRSetsWithOpts(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (zpa1 []*_sourceHcloud.ZoneRRSet, err error) {
+	m.record("AllRRSetsWithOpts", ctx, zone, opts)
+	if m.AllRRSetsWithOptsStub == nil {
+		panic(notStubbed("MockZoneClient.AllRRSetsWithOpts"))
+	}
+	return m.AllRRSetsWithOptsStub(ctx, zone, opts)
+}
+
+// AllWithOpts implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) AllWithOpts(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (zpa1 []*_sourceHcloud.Zone, err error) {
+	m.record("AllWithOpts", ctx, opts)
+	if m.AllWithOptsStub == nil {
+		panic(notStubbed("MockZoneClient.AllWithOpts"))
+	}
+	return m.AllWithOptsStub(ctx, opts)
+}
+
+// ChangePrimaryNameservers implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ChangePrimaryNameservers(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangePrimaryNameserversOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangePrimaryNameservers", ctx, zone, opts)
+	if m.ChangePrimaryNameserversStub == nil {
+		panic(notStubbed("MockZoneClient.ChangePrimaryNameservers"))
+	}
+	return m.ChangePrimaryNameserversStub(ctx, zone, opts)
+}
+
+// ChangeProtection implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ChangeProtection(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeProtection", ctx, zone, opts)
+	if m.ChangeProtectionStub == nil {
+		panic(notStubbed("MockZoneClient.ChangeProtection"))
+	}
+	return m.ChangeProtectionStub(ctx, zone, opts)
+}
+
+// ChangeRRSetProtection implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ChangeRRSetProtection(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetChangeProtectionOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeRRSetProtection", ctx, rrset, opts)
+	if m.ChangeRRSetProtectionStub == nil {
+		panic(notStubbed("MockZoneClient.ChangeRRSetProtection"))
+	}
+	return m.ChangeRRSetProtectionStub(ctx, rrset, opts)
+}
+
+// ChangeRRSetTTL implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ChangeRRSetTTL(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetChangeTTLOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeRRSetTTL", ctx, rrset, opts)
+	if m.ChangeRRSetTTLStub == nil {
+		panic(notStubbed("MockZoneClient.ChangeRRSetTTL"))
+	}
+	return m.ChangeRRSetTTLStub(ctx, rrset, opts)
+}
+
+// ChangeTTL implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ChangeTTL(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneChangeTTLOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ChangeTTL", ctx, zone, opts)
+	if m.ChangeTTLStub == nil {
+		panic(notStubbed("MockZoneClient.ChangeTTL"))
+	}
+	return m.ChangeTTLStub(ctx, zone, opts)
+}
+
+// Create implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) Create(ctx context.Context, opts _sourceHcloud.ZoneCreateOpts) (z1 _sourceHcloud.ZoneCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Create", ctx, opts)
+	if m.CreateStub == nil {
+		panic(notStubbed("MockZoneClient.Create"))
+	}
+	return m.CreateStub(ctx, opts)
+}
+
+// CreateRRSet implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) CreateRRSet(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetCreateOpts) (z1 _sourceHcloud.ZoneRRSetCreateResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("CreateRRSet", ctx, zone, opts)
+	if m.CreateRRSetStub == nil {
+		panic(notStubbed("MockZoneClient.CreateRRSet"))
+	}
+	return m.CreateRRSetStub(ctx, zone, opts)
+}
+
+// Delete implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) Delete(ctx context.Context, zone *_sourceHcloud.Zone) (z1 _sourceHcloud.ZoneDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Delete", ctx, zone)
+	if m.DeleteStub == nil {
+		panic(notStubbed("MockZoneClient.Delete"))
+	}
+	return m.DeleteStub(ctx, zone)
+}
+
+// DeleteRRSet implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) DeleteRRSet(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet) (z1 _sourceHcloud.ZoneRRSetDeleteResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("DeleteRRSet", ctx, rrset)
+	if m.DeleteRRSetStub == nil {
+		panic(notStubbed("MockZoneClient.DeleteRRSet"))
+	}
+	return m.DeleteRRSetStub(ctx, rrset)
+}
+
+// ExportZonefile implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ExportZonefile(ctx context.Context, zone *_sourceHcloud.Zone) (z1 _sourceHcloud.ZoneExportZonefileResult, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ExportZonefile", ctx, zone)
+	if m.ExportZonefileStub == nil {
+		panic(notStubbed("MockZoneClient.ExportZonefile"))
+	}
+	return m.ExportZonefileStub(ctx, zone)
+}
+
+// Get implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) Get(ctx context.Context, idOrName string) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Get", ctx, idOrName)
+	if m.GetStub == nil {
+		panic(notStubbed("MockZoneClient.Get"))
+	}
+	return m.GetStub(ctx, idOrName)
+}
+
+// GetByID implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) GetByID(ctx context.Context, id int64) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByID", ctx, id)
+	if m.GetByIDStub == nil {
+		panic(notStubbed("MockZoneClient.GetByID"))
+	}
+	return m.GetByIDStub(ctx, id)
+}
+
+// GetByName implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) GetByName(ctx context.Context, name string) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetByName", ctx, name)
+	if m.GetByNameStub == nil {
+		panic(notStubbed("MockZoneClient.GetByName"))
+	}
+	return m.GetByNameStub(ctx, name)
+}
+
+// GetRRSetByID implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) GetRRSetByID(ctx context.Context, zone *_sourceHcloud.Zone, rrsetID string) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetRRSetByID", ctx, zone, rrsetID)
+	if m.GetRRSetByIDStub == nil {
+		panic(notStubbed("MockZoneClient.GetRRSetByID"))
+	}
+	return m.GetRRSetByIDStub(ctx, zone, rrsetID)
+}
+
+// GetRRSetByNameAndType implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) GetRRSetByNameAndType(ctx context.Context, zone *_sourceHcloud.Zone, rrsetName string, rrsetType _sourceHcloud.ZoneRRSetType) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error) {
+	m.record("GetRRSetByNameAndType", ctx, zone, rrsetName, rrsetType)
+	if m.GetRRSetByNameAndTypeStub == nil {
+		panic(notStubbed("MockZoneClient.GetRRSetByNameAndType"))
+	}
+	return m.GetRRSetByNameAndTypeStub(ctx, zone, rrsetName, rrsetType)
+}
+
+// ImportZonefile implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ImportZonefile(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneImportZonefileOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ImportZonefile", ctx, zone, opts)
+	if m.ImportZonefileStub == nil {
+		panic(notStubbed("MockZoneClient.ImportZonefile"))
+	}
+	return m.ImportZonefileStub(ctx, zone, opts)
+}
+
+// Iter implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) Iter(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (p1 iter.Seq2[*_sourceHcloud.Zone, error]) {
+	m.record("Iter", ctx, opts)
+	if m.IterStub == nil {
+		panic(notStubbed("MockZoneClient.Iter"))
+	}
+	return m.IterStub(ctx, opts)
+}
+
+// IterRRSets implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) IterRRSets(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (p1 iter.Seq2[*_sourceHcloud.ZoneRRSet, error]) {
+	m.record("IterRRSets", ctx, zone, opts)
+	if m.IterRRSetsStub == nil {
+		panic(notStubbed("MockZoneClient.IterRRSets"))
+	}
+	return m.IterRRSetsStub(ctx, zone, opts)
+}
+
+// List implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) List(ctx context.Context, opts _sourceHcloud.ZoneListOpts) (zpa1 []*_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error) {
+	m.record("List", ctx, opts)
+	if m.ListStub == nil {
+		panic(notStubbed("MockZoneClient.List"))
+	}
+	return m.ListStub(ctx, opts)
+}
+
+// ListRRSets implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) ListRRSets(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneRRSetListOpts) (zpa1 []*_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error) {
+	m.record("ListRRSets", ctx, zone, opts)
+	if m.ListRRSetsStub == nil {
+		panic(notStubbed("MockZoneClient.ListRRSets"))
+	}
+	return m.ListRRSetsStub(ctx, zone, opts)
+}
+
+// RemoveRRSetRecords implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) RemoveRRSetRecords(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetRemoveRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("RemoveRRSetRecords", ctx, rrset, opts)
+	if m.RemoveRRSetRecordsStub == nil {
+		panic(notStubbed("MockZoneClient.RemoveRRSetRecords"))
+	}
+	return m.RemoveRRSetRecordsStub(ctx, rrset, opts)
+}
+
+// SetRRSetRecords implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) SetRRSetRecords(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetSetRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("SetRRSetRecords", ctx, rrset, opts)
+	if m.SetRRSetRecordsStub == nil {
+		panic(notStubbed("MockZoneClient.SetRRSetRecords"))
+	}
+	return m.SetRRSetRecordsStub(ctx, rrset, opts)
+}
+
+// Update implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) Update(ctx context.Context, zone *_sourceHcloud.Zone, opts _sourceHcloud.ZoneUpdateOpts) (zp1 *_sourceHcloud.Zone, rp1 *_sourceHcloud.Response, err error) {
+	m.record("Update", ctx, zone, opts)
+	if m.UpdateStub == nil {
+		panic(notStubbed("MockZoneClient.Update"))
+	}
+	return m.UpdateStub(ctx, zone, opts)
+}
+
+// UpdateRRSet implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) UpdateRRSet(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetUpdateOpts) (zp1 *_sourceHcloud.ZoneRRSet, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateRRSet", ctx, rrset, opts)
+	if m.UpdateRRSetStub == nil {
+		panic(notStubbed("MockZoneClient.UpdateRRSet"))
+	}
+	return m.UpdateRRSetStub(ctx, rrset, opts)
+}
+
+// UpdateRRSetRecords implements [_sourceHcloud.IZoneClient].
+func (m *MockZoneClient) UpdateRRSetRecords(ctx context.Context, rrset *_sourceHcloud.ZoneRRSet, opts _sourceHcloud.ZoneRRSetUpdateRecordsOpts) (ap1 *_sourceHcloud.Action, rp1 *_sourceHcloud.Response, err error) {
+	m.record("UpdateRRSetRecords", ctx, rrset, opts)
+	if m.UpdateRRSetRecordsStub == nil {
+		panic(notStubbed("MockZoneClient.UpdateRRSetRecords"))
+	}
+	return m.UpdateRRSetRecordsStub(ctx, rrset, opts)
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -219,6 +220,19 @@ func (c *ImageClient) AllWithOpts(ctx context.Context, opts ImageListOpts) ([]*I
 	})
 }
 
+// Iter returns an iterator over all images for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ImageClient) Iter(ctx context.Context, opts ImageListOpts) iter.Seq2[*Image, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Image, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // Delete deletes an image.
 func (c *ImageClient) Delete(ctx context.Context, image *Image) (*Response, error) {
 	const opPath = "/images/%d"
@@ -71,6 +71,63 @@ func (i *Instrumenter) InstrumentedRoundTripper(transport http.RoundTripper) htt
 	)
 }
 
+// CacheCounters returns the counters for the response cache hits and misses per API
+// endpoint.
+func (i *Instrumenter) CacheCounters() (hits *prometheus.CounterVec, misses *prometheus.CounterVec) {
+	hits = registerOrReuse(
+		i.instrumentationRegistry,
+		prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: fmt.Sprintf("hcloud_%s_cache_hits_total", i.subsystemIdentifier),
+				Help: fmt.Sprintf("A counter for responses served from the cache of the hcloud %s per endpoint.", i.subsystemIdentifier),
+			},
+			[]string{"api_endpoint"},
+		),
+	)
+
+	misses = registerOrReuse(
+		i.instrumentationRegistry,
+		prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: fmt.Sprintf("hcloud_%s_cache_misses_total", i.subsystemIdentifier),
+				Help: fmt.Sprintf("A counter for cacheable requests not found in the cache of the hcloud %s per endpoint.", i.subsystemIdentifier),
+			},
+			[]string{"api_endpoint"},
+		),
+	)
+
+	return hits, misses
+}
+
+// CircuitBreakerMetrics returns the gauge for the circuit breaker state, and the
+// counter for the requests rejected by the circuit breaker. The metrics are labelled
+// with the name of the client, so multiple clients can share the same registry.
+func (i *Instrumenter) CircuitBreakerMetrics(client string) (state prometheus.Gauge, rejected prometheus.Counter) {
+	states := registerOrReuse(
+		i.instrumentationRegistry,
+		prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: fmt.Sprintf("hcloud_%s_circuit_breaker_state", i.subsystemIdentifier),
+				Help: fmt.Sprintf("A gauge of the circuit breaker state of the hcloud %s client (0 = closed, 1 = half-open, 2 = open).", i.subsystemIdentifier),
+			},
+			[]string{"client"},
+		),
+	)
+
+	rejections := registerOrReuse(
+		i.instrumentationRegistry,
+		prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: fmt.Sprintf("hcloud_%s_circuit_breaker_rejected_requests_total", i.subsystemIdentifier),
+				Help: fmt.Sprintf("A counter for requests to the hcloud %s rejected by the circuit breaker.", i.subsystemIdentifier),
+			},
+			[]string{"client"},
+		),
+	)
+
+	return states.WithLabelValues(client), rejections.WithLabelValues(client)
+}
+
 // instrumentRoundTripperEndpoint implements a hcloud specific round tripper to count requests per API endpoint
 // numeric IDs are removed from the URI Path.
 //
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +16,21 @@ func TestMultipleInstrumentedClients(t *testing.T) {
 		// Following code should run without panicking
 		New("test", reg).InstrumentedRoundTripper(http.DefaultTransport)
 		New("test", reg).InstrumentedRoundTripper(http.DefaultTransport)
+		New("test", reg).CacheCounters()
+		New("test", reg).CacheCounters()
+		New("test", reg).CircuitBreakerMetrics("a")
+		New("test", reg).CircuitBreakerMetrics("b")
+	})
+
+	t.Run("should not share the circuit breaker state", func(t *testing.T) {
+		stateA, _ := New("test", reg).CircuitBreakerMetrics("a")
+		stateB, _ := New("test", reg).CircuitBreakerMetrics("b")
+
+		stateA.Set(2)
+		stateB.Set(0)
+
+		assert.InDelta(t, 2, testutil.ToFloat64(stateA), 0)
+		assert.InDelta(t, 0, testutil.ToFloat64(stateB), 0)
 	})
 }
 
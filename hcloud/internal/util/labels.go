@@ -0,0 +1,10 @@
+package util
+
+import "regexp"
+
+// LabelKeyRegexp matches the valid label keys.
+var LabelKeyRegexp = regexp.MustCompile(
+	`^([a-z0-9A-Z]((?:[\-_.]|[a-z0-9A-Z]){0,253}[a-z0-9A-Z])?/)?[a-z0-9A-Z]((?:[\-_.]|[a-z0-9A-Z]|){0,61}[a-z0-9A-Z])?$`)
+
+// LabelValueRegexp matches the valid label values.
+var LabelValueRegexp = regexp.MustCompile(`^(([a-z0-9A-Z](?:[\-_.]|[a-z0-9A-Z]){0,61})?[a-z0-9A-Z]$|$)`)
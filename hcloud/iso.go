@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -136,3 +137,16 @@ func (c *ISOClient) AllWithOpts(ctx context.Context, opts ISOListOpts) ([]*ISO,
 		return c.List(ctx, opts)
 	})
 }
+
+// Iter returns an iterator over all ISOs for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ISOClient) Iter(ctx context.Context, opts ISOListOpts) iter.Seq2[*ISO, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ISO, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
@@ -2,12 +2,12 @@ package hcloud
 
 import (
 	"fmt"
-	"regexp"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/util"
 )
 
-var keyRegexp = regexp.MustCompile(
-	`^([a-z0-9A-Z]((?:[\-_.]|[a-z0-9A-Z]){0,253}[a-z0-9A-Z])?/)?[a-z0-9A-Z]((?:[\-_.]|[a-z0-9A-Z]|){0,61}[a-z0-9A-Z])?$`)
-var valueRegexp = regexp.MustCompile(`^(([a-z0-9A-Z](?:[\-_.]|[a-z0-9A-Z]){0,61})?[a-z0-9A-Z]$|$)`)
+var keyRegexp = util.LabelKeyRegexp
+var valueRegexp = util.LabelValueRegexp
 
 func ValidateResourceLabels(labels map[string]any) (bool, error) {
 	for k, v := range labels {
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"slices"
@@ -338,6 +339,19 @@ func (c *LoadBalancerClient) AllWithOpts(ctx context.Context, opts LoadBalancerL
 	})
 }
 
+// Iter returns an iterator over all Load Balancers for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *LoadBalancerClient) Iter(ctx context.Context, opts LoadBalancerListOpts) iter.Seq2[*LoadBalancer, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*LoadBalancer, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // LoadBalancerUpdateOpts specifies options for updating a Load Balancer.
 type LoadBalancerUpdateOpts struct {
 	Name   string
@@ -462,6 +476,7 @@ type LoadBalancerCreateResult struct {
 func (c *LoadBalancerClient) Create(ctx context.Context, opts LoadBalancerCreateOpts) (LoadBalancerCreateResult, *Response, error) {
 	const opPath = "/load_balancers"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := LoadBalancerCreateResult{}
 
@@ -0,0 +1,586 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// LoadBalancerReconcileOpts specifies the desired state of a [LoadBalancer].
+//
+// Each field left nil is not managed, and the matching part of the Load Balancer is
+// left untouched.
+type LoadBalancerReconcileOpts struct {
+	// LoadBalancerType is the desired type, identified by ID or name.
+	LoadBalancerType *LoadBalancerType
+	// Algorithm is the desired algorithm.
+	Algorithm *LoadBalancerAlgorithm
+	// PublicInterface reports whether the public interface is enabled.
+	PublicInterface *bool
+	// Networks is the desired set of networks the Load Balancer is attached to. The IP
+	// and IP range are only used when attaching the Load Balancer to a network.
+	Networks []LoadBalancerAttachToNetworkOpts
+	// Services is the desired set of services, identified by their listen port. The
+	// services are compared field by field, they must be fully specified, including
+	// their health check.
+	Services []LoadBalancerService
+	// Targets is the desired set of targets. A target with a different UsePrivateIP
+	// value is removed and added again.
+	Targets []LoadBalancerTarget
+}
+
+// Validate checks if options are valid.
+func (o LoadBalancerReconcileOpts) Validate() error {
+	for _, network := range o.Networks {
+		if network.Network == nil {
+			return missingField(network, "Network")
+		}
+	}
+	for _, target := range o.Targets {
+		if err := validateLoadBalancerTarget(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadBalancerServiceChange describes a service that is changed.
+type LoadBalancerServiceChange struct {
+	From LoadBalancerService
+	To   LoadBalancerService
+}
+
+// LoadBalancerDiff describes the changes needed for a [LoadBalancer] to match its
+// desired state.
+type LoadBalancerDiff struct {
+	// LoadBalancerType is the type to change to, nil if unchanged.
+	LoadBalancerType *LoadBalancerType
+	// Algorithm is the algorithm to change to, nil if unchanged.
+	Algorithm              *LoadBalancerAlgorithm
+	EnablePublicInterface  bool
+	DisablePublicInterface bool
+	AttachNetworks         []LoadBalancerAttachToNetworkOpts
+	DetachNetworks         []*Network
+	AddedServices          []LoadBalancerService
+	ChangedServices        []LoadBalancerServiceChange
+	RemovedServices        []LoadBalancerService
+	AddedTargets           []LoadBalancerTarget
+	RemovedTargets         []LoadBalancerTarget
+}
+
+// IsEmpty reports whether the Load Balancer already matches its desired state.
+func (d LoadBalancerDiff) IsEmpty() bool {
+	return len(d.operations()) == 0
+}
+
+// String returns a human readable plan of the changes, one operation per line, in the
+// order they are applied.
+func (d LoadBalancerDiff) String() string {
+	operations := d.operations()
+	lines := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		lines = append(lines, operation.description)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// loadBalancerOperation is a single change applied to a Load Balancer.
+type loadBalancerOperation struct {
+	description string
+	apply       func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error)
+}
+
+// operations returns the changes in the order they must be applied:
+//   - the type changes first, so that an upgraded type allows more services and targets,
+//   - networks are attached and the public interface is enabled before the targets
+//     using them are added,
+//   - services and targets are removed before new ones are added, to stay within the
+//     limits of the type,
+//   - networks are detached and the public interface is disabled once the targets
+//     using them are removed.
+func (d LoadBalancerDiff) operations() []loadBalancerOperation {
+	operations := make([]loadBalancerOperation, 0)
+
+	if d.LoadBalancerType != nil {
+		loadBalancerType := d.LoadBalancerType
+		operations = append(operations, loadBalancerOperation{
+			description: "~ type " + formatLoadBalancerType(loadBalancerType),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.ChangeType(ctx, loadBalancer, LoadBalancerChangeTypeOpts{LoadBalancerType: loadBalancerType})
+			},
+		})
+	}
+
+	for _, network := range d.AttachNetworks {
+		operations = append(operations, loadBalancerOperation{
+			description: fmt.Sprintf("+ network %d", network.Network.ID),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.AttachToNetwork(ctx, loadBalancer, network)
+			},
+		})
+	}
+
+	if d.EnablePublicInterface {
+		operations = append(operations, loadBalancerOperation{
+			description: "+ public interface",
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.EnablePublicInterface(ctx, loadBalancer)
+			},
+		})
+	}
+
+	if d.Algorithm != nil {
+		algorithm := d.Algorithm
+		operations = append(operations, loadBalancerOperation{
+			description: "~ algorithm " + string(algorithm.Type),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.ChangeAlgorithm(ctx, loadBalancer, LoadBalancerChangeAlgorithmOpts{Type: algorithm.Type})
+			},
+		})
+	}
+
+	for _, service := range d.RemovedServices {
+		operations = append(operations, loadBalancerOperation{
+			description: "- service " + formatLoadBalancerService(service),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.DeleteService(ctx, loadBalancer, service.ListenPort)
+			},
+		})
+	}
+
+	for _, change := range d.ChangedServices {
+		operations = append(operations, loadBalancerOperation{
+			description: "~ service " + formatLoadBalancerService(change.From) + " => " + formatLoadBalancerService(change.To),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.UpdateService(ctx, loadBalancer, change.To.ListenPort, loadBalancerUpdateServiceOptsFor(change.To))
+			},
+		})
+	}
+
+	for _, service := range d.AddedServices {
+		operations = append(operations, loadBalancerOperation{
+			description: "+ service " + formatLoadBalancerService(service),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.AddService(ctx, loadBalancer, loadBalancerAddServiceOptsFor(service))
+			},
+		})
+	}
+
+	for _, target := range d.RemovedTargets {
+		operations = append(operations, loadBalancerOperation{
+			description: "- target " + formatLoadBalancerTarget(target),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.removeTarget(ctx, loadBalancer, loadBalancerRemoveTargetRequestFor(target))
+			},
+		})
+	}
+
+	for _, target := range d.AddedTargets {
+		operations = append(operations, loadBalancerOperation{
+			description: "+ target " + formatLoadBalancerTarget(target),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.addTarget(ctx, loadBalancer, loadBalancerAddTargetRequestFor(target))
+			},
+		})
+	}
+
+	if d.DisablePublicInterface {
+		operations = append(operations, loadBalancerOperation{
+			description: "- public interface",
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.DisablePublicInterface(ctx, loadBalancer)
+			},
+		})
+	}
+
+	for _, network := range d.DetachNetworks {
+		operations = append(operations, loadBalancerOperation{
+			description: fmt.Sprintf("- network %d", network.ID),
+			apply: func(ctx context.Context, c *LoadBalancerClient, loadBalancer *LoadBalancer) (*Action, *Response, error) {
+				return c.DetachFromNetwork(ctx, loadBalancer, LoadBalancerDetachFromNetworkOpts{Network: network})
+			},
+		})
+	}
+
+	return operations
+}
+
+// DiffLoadBalancer computes the changes needed for the Load Balancer to match the
+// desired state. The desired state must be valid, see [LoadBalancerReconcileOpts.Validate].
+func DiffLoadBalancer(loadBalancer *LoadBalancer, opts LoadBalancerReconcileOpts) LoadBalancerDiff {
+	diff := LoadBalancerDiff{}
+
+	if opts.LoadBalancerType != nil && !sameLoadBalancerType(loadBalancer.LoadBalancerType, opts.LoadBalancerType) {
+		diff.LoadBalancerType = opts.LoadBalancerType
+	}
+
+	if opts.Algorithm != nil && opts.Algorithm.Type != loadBalancer.Algorithm.Type {
+		diff.Algorithm = opts.Algorithm
+	}
+
+	if opts.PublicInterface != nil && *opts.PublicInterface != loadBalancer.PublicNet.Enabled {
+		diff.EnablePublicInterface = *opts.PublicInterface
+		diff.DisablePublicInterface = !*opts.PublicInterface
+	}
+
+	if opts.Networks != nil {
+		for _, network := range opts.Networks {
+			if !slices.ContainsFunc(loadBalancer.PrivateNet, func(o LoadBalancerPrivateNet) bool { return o.Network.ID == network.Network.ID }) {
+				diff.AttachNetworks = append(diff.AttachNetworks, network)
+			}
+		}
+		for _, privateNet := range loadBalancer.PrivateNet {
+			if !slices.ContainsFunc(opts.Networks, func(o LoadBalancerAttachToNetworkOpts) bool { return o.Network.ID == privateNet.Network.ID }) {
+				diff.DetachNetworks = append(diff.DetachNetworks, privateNet.Network)
+			}
+		}
+	}
+
+	if opts.Services != nil {
+		for _, service := range opts.Services {
+			i := slices.IndexFunc(loadBalancer.Services, func(o LoadBalancerService) bool { return o.ListenPort == service.ListenPort })
+			switch {
+			case i < 0:
+				diff.AddedServices = append(diff.AddedServices, service)
+			case !equalLoadBalancerService(loadBalancer.Services[i], service):
+				diff.ChangedServices = append(diff.ChangedServices, LoadBalancerServiceChange{From: loadBalancer.Services[i], To: service})
+			}
+		}
+		for _, service := range loadBalancer.Services {
+			if !slices.ContainsFunc(opts.Services, func(o LoadBalancerService) bool { return o.ListenPort == service.ListenPort }) {
+				diff.RemovedServices = append(diff.RemovedServices, service)
+			}
+		}
+	}
+
+	if opts.Targets != nil {
+		for _, target := range opts.Targets {
+			if !slices.ContainsFunc(loadBalancer.Targets, func(o LoadBalancerTarget) bool { return equalLoadBalancerTarget(o, target) }) {
+				diff.AddedTargets = append(diff.AddedTargets, target)
+			}
+		}
+		for _, target := range loadBalancer.Targets {
+			if !slices.ContainsFunc(opts.Targets, func(o LoadBalancerTarget) bool { return equalLoadBalancerTarget(o, target) }) {
+				diff.RemovedTargets = append(diff.RemovedTargets, target)
+			}
+		}
+	}
+
+	return diff
+}
+
+// LoadBalancerReconcileResult is the result of [LoadBalancerClient.Reconcile].
+type LoadBalancerReconcileResult struct {
+	Diff    LoadBalancerDiff
+	Actions []*Action
+}
+
+// Plan fetches the Load Balancer and computes the changes needed to match the desired
+// state, without applying them.
+func (c *LoadBalancerClient) Plan(ctx context.Context, loadBalancer *LoadBalancer, opts LoadBalancerReconcileOpts) (LoadBalancerDiff, *Response, error) {
+	if err := opts.Validate(); err != nil {
+		return LoadBalancerDiff{}, nil, err
+	}
+
+	live, resp, err := c.GetByID(ctx, loadBalancer.ID)
+	if err != nil {
+		return LoadBalancerDiff{}, resp, err
+	}
+	if live == nil {
+		return LoadBalancerDiff{}, resp, fmt.Errorf("load balancer not found: %d", loadBalancer.ID)
+	}
+
+	return DiffLoadBalancer(live, opts), resp, nil
+}
+
+// Reconcile fetches the Load Balancer, computes the changes needed to match the desired
+// state, and applies them one after the other, waiting for each resulting action to
+// complete before applying the next change.
+//
+// Use [LoadBalancerClient.Plan] to preview the changes.
+func (c *LoadBalancerClient) Reconcile(ctx context.Context, loadBalancer *LoadBalancer, opts LoadBalancerReconcileOpts) (LoadBalancerReconcileResult, *Response, error) {
+	result := LoadBalancerReconcileResult{}
+
+	diff, resp, err := c.Plan(ctx, loadBalancer, opts)
+	if err != nil {
+		return result, resp, err
+	}
+	result.Diff = diff
+
+	for _, operation := range diff.operations() {
+		var action *Action
+		action, resp, err = operation.apply(ctx, c, loadBalancer)
+		if err != nil {
+			return result, resp, fmt.Errorf("%s: %w", operation.description, err)
+		}
+		result.Actions = append(result.Actions, action)
+
+		if err := c.client.Action.WaitFor(ctx, action); err != nil {
+			return result, resp, fmt.Errorf("%s: %w", operation.description, err)
+		}
+	}
+
+	return result, resp, nil
+}
+
+// sameLoadBalancerType reports whether the desired type, identified by ID or name, is
+// the live type.
+func sameLoadBalancerType(live, desired *LoadBalancerType) bool {
+	if live == nil {
+		return false
+	}
+	if desired.ID != 0 {
+		return desired.ID == live.ID
+	}
+	return desired.Name == live.Name
+}
+
+// equalLoadBalancerService reports whether both services are identical. The HTTP
+// settings are only compared for HTTP(S) services.
+func equalLoadBalancerService(a, b LoadBalancerService) bool {
+	if a.Protocol != b.Protocol ||
+		a.ListenPort != b.ListenPort ||
+		a.DestinationPort != b.DestinationPort ||
+		a.Proxyprotocol != b.Proxyprotocol {
+		return false
+	}
+
+	if a.Protocol != LoadBalancerServiceProtocolTCP {
+		if a.HTTP.CookieName != b.HTTP.CookieName ||
+			a.HTTP.CookieLifetime != b.HTTP.CookieLifetime ||
+			a.HTTP.RedirectHTTP != b.HTTP.RedirectHTTP ||
+			a.HTTP.StickySessions != b.HTTP.StickySessions ||
+			a.HTTP.TimeoutIdle != b.HTTP.TimeoutIdle ||
+			!slices.Equal(sortedCertificateIDs(a.HTTP.Certificates), sortedCertificateIDs(b.HTTP.Certificates)) {
+			return false
+		}
+	}
+
+	return equalLoadBalancerServiceHealthCheck(a.HealthCheck, b.HealthCheck)
+}
+
+func equalLoadBalancerServiceHealthCheck(a, b LoadBalancerServiceHealthCheck) bool {
+	if a.Protocol != b.Protocol ||
+		a.Port != b.Port ||
+		a.Interval != b.Interval ||
+		a.Timeout != b.Timeout ||
+		a.Retries != b.Retries {
+		return false
+	}
+
+	if a.Protocol == LoadBalancerServiceProtocolTCP || (a.HTTP == nil && b.HTTP == nil) {
+		return true
+	}
+	if a.HTTP == nil || b.HTTP == nil {
+		return false
+	}
+	return a.HTTP.Domain == b.HTTP.Domain &&
+		a.HTTP.Path == b.HTTP.Path &&
+		a.HTTP.Response == b.HTTP.Response &&
+		a.HTTP.TLS == b.HTTP.TLS &&
+		slices.Equal(slices.Sorted(slices.Values(a.HTTP.StatusCodes)), slices.Sorted(slices.Values(b.HTTP.StatusCodes)))
+}
+
+// equalLoadBalancerTarget reports whether both targets point at the same resource,
+// with the same settings.
+func equalLoadBalancerTarget(a, b LoadBalancerTarget) bool {
+	if a.Type != b.Type || a.UsePrivateIP != b.UsePrivateIP {
+		return false
+	}
+	switch a.Type {
+	case LoadBalancerTargetTypeServer:
+		return a.Server != nil && b.Server != nil &&
+			a.Server.Server != nil && b.Server.Server != nil &&
+			a.Server.Server.ID == b.Server.Server.ID
+	case LoadBalancerTargetTypeLabelSelector:
+		return a.LabelSelector != nil && b.LabelSelector != nil && a.LabelSelector.Selector == b.LabelSelector.Selector
+	case LoadBalancerTargetTypeIP:
+		return a.IP != nil && b.IP != nil && a.IP.IP == b.IP.IP
+	}
+	return false
+}
+
+// validateLoadBalancerTarget checks that the target references the resource matching
+// its type.
+func validateLoadBalancerTarget(target LoadBalancerTarget) error {
+	switch target.Type {
+	case LoadBalancerTargetTypeServer:
+		if target.Server == nil || target.Server.Server == nil {
+			return missingField(target, "Server")
+		}
+	case LoadBalancerTargetTypeLabelSelector:
+		if target.LabelSelector == nil {
+			return missingField(target, "LabelSelector")
+		}
+	case LoadBalancerTargetTypeIP:
+		if target.IP == nil {
+			return missingField(target, "IP")
+		}
+	default:
+		return invalidFieldValue(target, "Type", target.Type)
+	}
+	return nil
+}
+
+func sortedCertificateIDs(certificates []*Certificate) []int64 {
+	result := make([]int64, 0, len(certificates))
+	for _, certificate := range certificates {
+		result = append(result, certificate.ID)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func loadBalancerAddServiceOptsFor(service LoadBalancerService) LoadBalancerAddServiceOpts {
+	opts := LoadBalancerAddServiceOpts{
+		Protocol:        service.Protocol,
+		ListenPort:      Ptr(service.ListenPort),
+		DestinationPort: Ptr(service.DestinationPort),
+		Proxyprotocol:   Ptr(service.Proxyprotocol),
+		HealthCheck: &LoadBalancerAddServiceOptsHealthCheck{
+			Protocol: service.HealthCheck.Protocol,
+			Port:     Ptr(service.HealthCheck.Port),
+			Interval: Ptr(service.HealthCheck.Interval),
+			Timeout:  Ptr(service.HealthCheck.Timeout),
+			Retries:  Ptr(service.HealthCheck.Retries),
+		},
+	}
+	if service.Protocol != LoadBalancerServiceProtocolTCP {
+		opts.HTTP = &LoadBalancerAddServiceOptsHTTP{
+			CookieName:     Ptr(service.HTTP.CookieName),
+			CookieLifetime: Ptr(service.HTTP.CookieLifetime),
+			RedirectHTTP:   Ptr(service.HTTP.RedirectHTTP),
+			StickySessions: Ptr(service.HTTP.StickySessions),
+			TimeoutIdle:    Ptr(service.HTTP.TimeoutIdle),
+		}
+		if service.Protocol == LoadBalancerServiceProtocolHTTPS {
+			opts.HTTP.Certificates = service.HTTP.Certificates
+		}
+	}
+	if http := service.HealthCheck.HTTP; http != nil && service.HealthCheck.Protocol != LoadBalancerServiceProtocolTCP {
+		opts.HealthCheck.HTTP = &LoadBalancerAddServiceOptsHealthCheckHTTP{
+			Domain:      Ptr(http.Domain),
+			Path:        Ptr(http.Path),
+			Response:    Ptr(http.Response),
+			StatusCodes: http.StatusCodes,
+			TLS:         Ptr(http.TLS),
+		}
+	}
+	return opts
+}
+
+func loadBalancerUpdateServiceOptsFor(service LoadBalancerService) LoadBalancerUpdateServiceOpts {
+	opts := LoadBalancerUpdateServiceOpts{
+		Protocol:        service.Protocol,
+		DestinationPort: Ptr(service.DestinationPort),
+		Proxyprotocol:   Ptr(service.Proxyprotocol),
+		HealthCheck: &LoadBalancerUpdateServiceOptsHealthCheck{
+			Protocol: service.HealthCheck.Protocol,
+			Port:     Ptr(service.HealthCheck.Port),
+			Interval: Ptr(service.HealthCheck.Interval),
+			Timeout:  Ptr(service.HealthCheck.Timeout),
+			Retries:  Ptr(service.HealthCheck.Retries),
+		},
+	}
+	if service.Protocol != LoadBalancerServiceProtocolTCP {
+		opts.HTTP = &LoadBalancerUpdateServiceOptsHTTP{
+			CookieName:     Ptr(service.HTTP.CookieName),
+			CookieLifetime: Ptr(service.HTTP.CookieLifetime),
+			RedirectHTTP:   Ptr(service.HTTP.RedirectHTTP),
+			StickySessions: Ptr(service.HTTP.StickySessions),
+			TimeoutIdle:    Ptr(service.HTTP.TimeoutIdle),
+		}
+		if service.Protocol == LoadBalancerServiceProtocolHTTPS {
+			opts.HTTP.Certificates = service.HTTP.Certificates
+		}
+	}
+	if http := service.HealthCheck.HTTP; http != nil && service.HealthCheck.Protocol != LoadBalancerServiceProtocolTCP {
+		opts.HealthCheck.HTTP = &LoadBalancerUpdateServiceOptsHealthCheckHTTP{
+			Domain:      Ptr(http.Domain),
+			Path:        Ptr(http.Path),
+			Response:    Ptr(http.Response),
+			StatusCodes: http.StatusCodes,
+			TLS:         Ptr(http.TLS),
+		}
+	}
+	return opts
+}
+
+func loadBalancerAddTargetRequestFor(target LoadBalancerTarget) schema.LoadBalancerActionAddTargetRequest {
+	reqBody := schema.LoadBalancerActionAddTargetRequest{
+		Type:         string(target.Type),
+		UsePrivateIP: Ptr(target.UsePrivateIP),
+	}
+	switch target.Type {
+	case LoadBalancerTargetTypeServer:
+		reqBody.Server = &schema.LoadBalancerActionAddTargetRequestServer{ID: target.Server.Server.ID}
+	case LoadBalancerTargetTypeLabelSelector:
+		reqBody.LabelSelector = &schema.LoadBalancerActionAddTargetRequestLabelSelector{Selector: target.LabelSelector.Selector}
+	case LoadBalancerTargetTypeIP:
+		// IP targets do not support private IPs.
+		reqBody.UsePrivateIP = nil
+		reqBody.IP = &schema.LoadBalancerActionAddTargetRequestIP{IP: target.IP.IP}
+	}
+	return reqBody
+}
+
+func loadBalancerRemoveTargetRequestFor(target LoadBalancerTarget) schema.LoadBalancerActionRemoveTargetRequest {
+	reqBody := schema.LoadBalancerActionRemoveTargetRequest{
+		Type: string(target.Type),
+	}
+	switch target.Type {
+	case LoadBalancerTargetTypeServer:
+		reqBody.Server = &schema.LoadBalancerActionRemoveTargetRequestServer{ID: target.Server.Server.ID}
+	case LoadBalancerTargetTypeLabelSelector:
+		reqBody.LabelSelector = &schema.LoadBalancerActionRemoveTargetRequestLabelSelector{Selector: target.LabelSelector.Selector}
+	case LoadBalancerTargetTypeIP:
+		reqBody.IP = &schema.LoadBalancerActionRemoveTargetRequestIP{IP: target.IP.IP}
+	}
+	return reqBody
+}
+
+func formatLoadBalancerType(loadBalancerType *LoadBalancerType) string {
+	if loadBalancerType.Name != "" {
+		return loadBalancerType.Name
+	}
+	return fmt.Sprintf("%d", loadBalancerType.ID)
+}
+
+func formatLoadBalancerService(service LoadBalancerService) string {
+	parts := []string{
+		fmt.Sprintf("%s %d->%d", service.Protocol, service.ListenPort, service.DestinationPort),
+		fmt.Sprintf("health check %s %d", service.HealthCheck.Protocol, service.HealthCheck.Port),
+	}
+	if http := service.HealthCheck.HTTP; http != nil && http.Path != "" {
+		parts = append(parts, http.Path)
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatLoadBalancerTarget(target LoadBalancerTarget) string {
+	var result string
+	switch target.Type {
+	case LoadBalancerTargetTypeServer:
+		if target.Server != nil && target.Server.Server != nil {
+			result = fmt.Sprintf("server %d", target.Server.Server.ID)
+		}
+	case LoadBalancerTargetTypeLabelSelector:
+		if target.LabelSelector != nil {
+			result = fmt.Sprintf("label_selector %s", target.LabelSelector.Selector)
+		}
+	case LoadBalancerTargetTypeIP:
+		if target.IP != nil {
+			result = fmt.Sprintf("ip %s", target.IP.IP)
+		}
+	}
+	if result == "" {
+		result = string(target.Type)
+	}
+	if target.UsePrivateIP {
+		result += " (private ip)"
+	}
+	return result
+}
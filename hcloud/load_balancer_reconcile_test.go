@@ -0,0 +1,280 @@
+package hcloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestDiffLoadBalancer(t *testing.T) {
+	healthCheck := LoadBalancerServiceHealthCheck{
+		Protocol: LoadBalancerServiceProtocolHTTP,
+		Port:     80,
+		Interval: 15 * time.Second,
+		Timeout:  10 * time.Second,
+		Retries:  3,
+		HTTP:     &LoadBalancerServiceHealthCheckHTTP{Path: "/healthz", StatusCodes: []string{"2??", "3??"}},
+	}
+	web := LoadBalancerService{
+		Protocol:        LoadBalancerServiceProtocolHTTP,
+		ListenPort:      80,
+		DestinationPort: 8080,
+		HTTP:            LoadBalancerServiceHTTP{CookieName: "HCLBSTICKY", CookieLifetime: 5 * time.Minute},
+		HealthCheck:     healthCheck,
+	}
+	ssh := LoadBalancerService{
+		Protocol:        LoadBalancerServiceProtocolTCP,
+		ListenPort:      22,
+		DestinationPort: 22,
+		HealthCheck:     LoadBalancerServiceHealthCheck{Protocol: LoadBalancerServiceProtocolTCP, Port: 22, Interval: 15 * time.Second, Timeout: 10 * time.Second, Retries: 3},
+	}
+	server := LoadBalancerTarget{Type: LoadBalancerTargetTypeServer, Server: &LoadBalancerTargetServer{Server: &Server{ID: 42}}}
+	selector := LoadBalancerTarget{Type: LoadBalancerTargetTypeLabelSelector, LabelSelector: &LoadBalancerTargetLabelSelector{Selector: "role=web"}, UsePrivateIP: true}
+
+	loadBalancer := &LoadBalancer{
+		ID:               1,
+		LoadBalancerType: &LoadBalancerType{ID: 1, Name: "lb11"},
+		Algorithm:        LoadBalancerAlgorithm{Type: LoadBalancerAlgorithmTypeRoundRobin},
+		PublicNet:        LoadBalancerPublicNet{Enabled: true},
+		PrivateNet:       []LoadBalancerPrivateNet{{Network: &Network{ID: 3}}},
+		Services:         []LoadBalancerService{web, ssh},
+		Targets:          []LoadBalancerTarget{server},
+	}
+
+	t.Run("changes", func(t *testing.T) {
+		webChanged := web
+		webChanged.HealthCheck.HTTP = &LoadBalancerServiceHealthCheckHTTP{Path: "/ready", StatusCodes: []string{"2??"}}
+
+		https := web
+		https.Protocol = LoadBalancerServiceProtocolHTTPS
+		https.ListenPort = 443
+		https.HTTP.Certificates = []*Certificate{{ID: 7}}
+
+		diff := DiffLoadBalancer(loadBalancer, LoadBalancerReconcileOpts{
+			LoadBalancerType: &LoadBalancerType{Name: "lb21"},
+			Algorithm:        &LoadBalancerAlgorithm{Type: LoadBalancerAlgorithmTypeRoundRobin},
+			PublicInterface:  Ptr(false),
+			Networks:         []LoadBalancerAttachToNetworkOpts{{Network: &Network{ID: 4}}},
+			Services:         []LoadBalancerService{webChanged, https},
+			Targets:          []LoadBalancerTarget{selector},
+		})
+
+		assert.Equal(t, &LoadBalancerType{Name: "lb21"}, diff.LoadBalancerType)
+		assert.Nil(t, diff.Algorithm)
+		assert.True(t, diff.DisablePublicInterface)
+		assert.Equal(t, []LoadBalancerServiceChange{{From: web, To: webChanged}}, diff.ChangedServices)
+		assert.Equal(t, []LoadBalancerService{https}, diff.AddedServices)
+		assert.Equal(t, []LoadBalancerService{ssh}, diff.RemovedServices)
+		assert.Equal(t, []LoadBalancerTarget{selector}, diff.AddedTargets)
+		assert.Equal(t, []LoadBalancerTarget{server}, diff.RemovedTargets)
+
+		assert.Equal(t, `~ type lb21
++ network 4
+- service tcp 22->22 health check tcp 22
+~ service http 80->8080 health check http 80 /healthz => http 80->8080 health check http 80 /ready
++ service https 443->8080 health check http 80 /healthz
+- target server 42
++ target label_selector role=web (private ip)
+- public interface
+- network 3`, diff.String())
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		// Order of the status codes is not relevant
+		web := web
+		web.HealthCheck.HTTP = &LoadBalancerServiceHealthCheckHTTP{Path: "/healthz", StatusCodes: []string{"3??", "2??"}}
+
+		diff := DiffLoadBalancer(loadBalancer, LoadBalancerReconcileOpts{
+			LoadBalancerType: &LoadBalancerType{ID: 1},
+			Algorithm:        &LoadBalancerAlgorithm{Type: LoadBalancerAlgorithmTypeRoundRobin},
+			PublicInterface:  Ptr(true),
+			Networks:         []LoadBalancerAttachToNetworkOpts{{Network: &Network{ID: 3}}},
+			Services:         []LoadBalancerService{ssh, web},
+			Targets:          []LoadBalancerTarget{server},
+		})
+		assert.True(t, diff.IsEmpty())
+		assert.Empty(t, diff.String())
+	})
+
+	t.Run("unmanaged", func(t *testing.T) {
+		diff := DiffLoadBalancer(loadBalancer, LoadBalancerReconcileOpts{})
+		assert.True(t, diff.IsEmpty())
+	})
+}
+
+func TestLoadBalancerClientReconcile(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/load_balancers/1",
+			Status: 200,
+			JSONRaw: `{
+				"load_balancer": {
+					"id": 1,
+					"algorithm": { "type": "round_robin" },
+					"public_net": { "enabled": true },
+					"targets": [
+						{ "type": "ip", "ip": { "ip": "203.0.113.1" }}
+					]
+				}
+			}`,
+		},
+		{
+			Method: "POST", Path: "/load_balancers/1/actions/change_algorithm",
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 10, "status": "running" }}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 10, "status": "success" }],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+		{
+			Method: "POST", Path: "/load_balancers/1/actions/add_service",
+			Want: func(t *testing.T, r *http.Request) {
+				var body schema.LoadBalancerActionAddServiceRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "tcp", body.Protocol)
+				assert.Equal(t, Ptr(443), body.ListenPort)
+				assert.Nil(t, body.HTTP)
+			},
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 11, "status": "success" }}`,
+		},
+		{
+			Method: "POST", Path: "/load_balancers/1/actions/remove_target",
+			Want: func(t *testing.T, r *http.Request) {
+				var body schema.LoadBalancerActionRemoveTargetRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "ip", body.Type)
+				assert.Equal(t, "203.0.113.1", body.IP.IP)
+			},
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 12, "status": "success" }}`,
+		},
+		{
+			Method: "POST", Path: "/load_balancers/1/actions/add_target",
+			Want: func(t *testing.T, r *http.Request) {
+				var body schema.LoadBalancerActionAddTargetRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "server", body.Type)
+				assert.Equal(t, int64(42), body.Server.ID)
+			},
+			Status:  201,
+			JSONRaw: `{ "action": { "id": 13, "status": "running" }}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=13&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{ "id": 13, "status": "error", "error": { "code": "target_already_defined", "message": "target already defined" }}],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+	})
+
+	result, _, err := client.LoadBalancer.Reconcile(ctx, &LoadBalancer{ID: 1}, LoadBalancerReconcileOpts{
+		Algorithm: &LoadBalancerAlgorithm{Type: LoadBalancerAlgorithmTypeLeastConnections},
+		Services: []LoadBalancerService{{
+			Protocol:        LoadBalancerServiceProtocolTCP,
+			ListenPort:      443,
+			DestinationPort: 443,
+			HealthCheck:     LoadBalancerServiceHealthCheck{Protocol: LoadBalancerServiceProtocolTCP, Port: 443},
+		}},
+		Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeServer, Server: &LoadBalancerTargetServer{Server: &Server{ID: 42}}}},
+	})
+	require.EqualError(t, err, "+ target server 42: target already defined (target_already_defined, 13)")
+	assert.Len(t, result.Diff.AddedTargets, 1)
+	assert.Len(t, result.Actions, 4)
+}
+
+func TestLoadBalancerClientPlan(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/load_balancers/2",
+			Status:  404,
+			JSONRaw: `{ "error": { "code": "not_found", "message": "load balancer not found" }}`,
+		},
+	})
+
+	_, _, err := client.LoadBalancer.Plan(ctx, &LoadBalancer{ID: 2}, LoadBalancerReconcileOpts{})
+	require.EqualError(t, err, "load balancer not found: 2")
+}
+
+func TestLoadBalancerReconcileOptsValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts LoadBalancerReconcileOpts
+		err  string
+	}{
+		{
+			name: "server target without server",
+			opts: LoadBalancerReconcileOpts{Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeServer}}},
+			err:  "missing field [Server] in [hcloud.LoadBalancerTarget]",
+		},
+		{
+			name: "server target with empty server",
+			opts: LoadBalancerReconcileOpts{Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeServer, Server: &LoadBalancerTargetServer{}}}},
+			err:  "missing field [Server] in [hcloud.LoadBalancerTarget]",
+		},
+		{
+			name: "label selector target without selector",
+			opts: LoadBalancerReconcileOpts{Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeLabelSelector}}},
+			err:  "missing field [LabelSelector] in [hcloud.LoadBalancerTarget]",
+		},
+		{
+			name: "ip target without ip",
+			opts: LoadBalancerReconcileOpts{Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeIP}}},
+			err:  "missing field [IP] in [hcloud.LoadBalancerTarget]",
+		},
+		{
+			name: "target without type",
+			opts: LoadBalancerReconcileOpts{Targets: []LoadBalancerTarget{{IP: &LoadBalancerTargetIP{IP: "10.0.0.1"}}}},
+			err:  "invalid value '' for field [Type] in [hcloud.LoadBalancerTarget]",
+		},
+		{
+			name: "network without network",
+			opts: LoadBalancerReconcileOpts{Networks: []LoadBalancerAttachToNetworkOpts{{}}},
+			err:  "missing field [Network] in [hcloud.LoadBalancerAttachToNetworkOpts]",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.EqualError(t, testCase.opts.Validate(), testCase.err)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, LoadBalancerReconcileOpts{
+			Networks: []LoadBalancerAttachToNetworkOpts{{Network: &Network{ID: 1}}},
+			Targets: []LoadBalancerTarget{
+				{Type: LoadBalancerTargetTypeServer, Server: &LoadBalancerTargetServer{Server: &Server{ID: 42}}},
+				{Type: LoadBalancerTargetTypeLabelSelector, LabelSelector: &LoadBalancerTargetLabelSelector{Selector: "role=web"}},
+				{Type: LoadBalancerTargetTypeIP, IP: &LoadBalancerTargetIP{IP: "10.0.0.1"}},
+			},
+		}.Validate())
+	})
+
+	t.Run("reconcile", func(t *testing.T) {
+		ctx, _, client := makeTestUtils(t)
+
+		// The options are validated before sending any request
+		_, _, err := client.LoadBalancer.Reconcile(ctx, &LoadBalancer{ID: 1}, LoadBalancerReconcileOpts{
+			Targets: []LoadBalancerTarget{{Type: LoadBalancerTargetTypeServer}},
+		})
+		require.EqualError(t, err, "missing field [Server] in [hcloud.LoadBalancerTarget]")
+	})
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -117,3 +118,16 @@ func (c *LoadBalancerTypeClient) AllWithOpts(ctx context.Context, opts LoadBalan
 		return c.List(ctx, opts)
 	})
 }
+
+// Iter returns an iterator over all Load Balancer types for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *LoadBalancerTypeClient) Iter(ctx context.Context, opts LoadBalancerTypeListOpts) iter.Seq2[*LoadBalancerType, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*LoadBalancerType, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
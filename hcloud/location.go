@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -112,3 +113,16 @@ func (c *LocationClient) AllWithOpts(ctx context.Context, opts LocationListOpts)
 		return c.List(ctx, opts)
 	})
 }
+
+// Iter returns an iterator over all locations for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *LocationClient) Iter(ctx context.Context, opts LocationListOpts) iter.Seq2[*Location, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Location, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
@@ -0,0 +1,72 @@
+package metadata
+
+import (
+	"sync"
+	"time"
+)
+
+// cache holds the successful responses of the Metadata server for a limited time.
+type cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// get returns the cached body for the url, if not expired. A nil cache is always empty.
+func (c *cache) get(url string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, url)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *cache) set(url string, body []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[url] = cacheEntry{body: body, expires: c.now().Add(c.ttl)}
+}
+
+// clear removes all the cached responses.
+func (c *cache) clear() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
@@ -3,6 +3,7 @@ package metadata
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -32,6 +33,11 @@ type Client struct {
 
 	httpClient              *http.Client
 	instrumentationRegistry prometheus.Registerer
+
+	retryMaxRetries  int
+	retryBackoffFunc BackoffFunc
+
+	cache *cache
 }
 
 // A ClientOption is used to configure a [Client].
@@ -65,6 +71,42 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// BackoffFunc returns the duration to wait before the next retry, given the number of
+// retries already done.
+type BackoffFunc func(retries int) time.Duration
+
+// RetryOpts defines the options used by [WithRetryOpts].
+type RetryOpts struct {
+	// MaxRetries is the maximum number of retries of a failed request. Defaults to 0,
+	// requests are not retried.
+	MaxRetries int
+	// BackoffFunc returns the duration to wait before the next retry. Defaults to an
+	// exponential backoff starting at 100 milliseconds, capped at 5 seconds.
+	BackoffFunc BackoffFunc
+}
+
+// WithRetryOpts configures a [Client] to retry requests failing with a network error, a
+// 5xx or a 429 status code.
+func WithRetryOpts(opts RetryOpts) ClientOption {
+	return func(client *Client) {
+		client.retryMaxRetries = opts.MaxRetries
+		if opts.BackoffFunc != nil {
+			client.retryBackoffFunc = opts.BackoffFunc
+		}
+	}
+}
+
+// WithCache configures a [Client] to cache successful responses for the given duration.
+// Failed responses are never cached.
+func WithCache(ttl time.Duration) ClientOption {
+	return func(client *Client) {
+		client.cache = nil
+		if ttl > 0 {
+			client.cache = newCache(ttl)
+		}
+	}
+}
+
 // WithApplication configures a Client with the given application name and
 // application version. The version may be blank. Programs are encouraged
 // to at least set an application name.
@@ -81,6 +123,8 @@ func NewClient(options ...ClientOption) *Client {
 		endpoint:   Endpoint,
 		httpClient: &http.Client{},
 		timeout:    5 * time.Second,
+
+		retryBackoffFunc: defaultBackoff,
 	}
 
 	for _, option := range options {
@@ -98,34 +142,90 @@ func NewClient(options ...ClientOption) *Client {
 
 // get executes an HTTP request against the API.
 func (c *Client) get(ctx context.Context, path string) (string, error) {
+	body, err := c.getRaw(ctx, c.endpoint, path)
+	return string(bytes.TrimSpace(body)), err
+}
+
+// getRaw executes an HTTP request against the endpoint, and returns the unmodified body.
+//
+// Successful responses are cached, and failed requests are retried, if configured.
+func (c *Client) getRaw(ctx context.Context, endpoint, path string) ([]byte, error) {
+	url := endpoint + path
+
+	if body, ok := c.cache.get(url); ok {
+		return body, nil
+	}
+
+	retries := 0
+	for {
+		body, err := c.do(ctx, url, path)
+		if err == nil {
+			c.cache.set(url, body)
+			return body, nil
+		}
+
+		if retries >= c.retryMaxRetries || ctx.Err() != nil || !retryable(err) {
+			return body, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return body, err
+		case <-time.After(c.retryBackoffFunc(retries)):
+			retries++
+		}
+	}
+}
+
+// do executes a single HTTP request against the url.
+func (c *Client) do(ctx context.Context, url, path string) ([]byte, error) {
 	ctx = ctxutil.SetOpPath(ctx, path)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+path, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	defer resp.Body.Close()
-	bodyBytes, err := io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	body := string(bytes.TrimSpace(bodyBytes))
-
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return body, fmt.Errorf("response status was %d", resp.StatusCode)
+		return body, statusError(resp.StatusCode)
 	}
 	return body, nil
 }
 
+// statusError is returned for responses with a non-2xx status code.
+type statusError int
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("response status was %d", int(e))
+}
+
+// retryable reports whether a request failing with the error may succeed when retried.
+func retryable(err error) bool {
+	var status statusError
+	if errors.As(err, &status) {
+		return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+	}
+	// Network errors
+	return true
+}
+
+func defaultBackoff(retries int) time.Duration {
+	return min(100*time.Millisecond<<min(retries, 10), 5*time.Second)
+}
+
 // IsHcloudServer checks if the currently called server is a hcloud server by calling a metadata endpoint
 // if the endpoint answers with a non-empty value this method returns true, otherwise false.
 //
@@ -235,7 +335,12 @@ func (c *Client) PrivateNetworks() (string, error) {
 }
 
 // PrivateNetworksWithContext returns details about the private networks the server is attached to.
-// Returns YAML (unparsed).
+// Returns YAML (unparsed), use [Client.ParsedPrivateNetworks] for the parsed details.
 func (c *Client) PrivateNetworksWithContext(ctx context.Context) (string, error) {
 	return c.get(ctx, "/private-networks")
 }
+
+// ClearCache removes all the responses cached by a [Client] configured with [WithCache].
+func (c *Client) ClearCache() {
+	c.cache.clear()
+}
@@ -292,3 +292,73 @@ func TestClient_PrivateNetworks(t *testing.T) {
   gateway: 192.168.0.1`
 	assert.Equal(t, expectedNetworks, privateNetworks)
 }
+
+func TestClient_WithRetryOpts(t *testing.T) {
+	env := newTestEnv()
+	defer env.Teardown()
+
+	calls := 0
+	env.Mux.HandleFunc("/hostname", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("my-server"))
+	})
+
+	client := NewClient(
+		WithEndpoint(env.Server.URL),
+		WithRetryOpts(RetryOpts{MaxRetries: 1, BackoffFunc: func(int) time.Duration { return 0 }}),
+	)
+
+	_, err := client.HostnameWithContext(context.Background())
+	require.EqualError(t, err, "response status was 502")
+	assert.Equal(t, 2, calls)
+
+	hostname, err := client.HostnameWithContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", hostname)
+}
+
+func TestClient_WithCache(t *testing.T) {
+	env := newTestEnv()
+	defer env.Teardown()
+
+	calls := 0
+	env.Mux.HandleFunc("/region", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte("eu-central"))
+	})
+
+	now := time.Now()
+	client := NewClient(WithEndpoint(env.Server.URL), WithCache(time.Minute))
+	client.cache.now = func() time.Time { return now }
+
+	// Failed responses are not cached
+	_, err := client.RegionWithContext(context.Background())
+	require.Error(t, err)
+
+	for range 2 {
+		region, err := client.RegionWithContext(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "eu-central", region)
+	}
+	assert.Equal(t, 2, calls)
+
+	now = now.Add(time.Minute)
+
+	_, err = client.RegionWithContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDefaultBackoff(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, defaultBackoff(0))
+	assert.Equal(t, 400*time.Millisecond, defaultBackoff(2))
+	assert.Equal(t, 5*time.Second, defaultBackoff(100))
+}
@@ -0,0 +1,203 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata/internal/schema"
+)
+
+// PrivateNetwork represents a private network the server is attached to.
+type PrivateNetwork struct {
+	IP           net.IP
+	AliasIPs     []net.IP
+	InterfaceNum int
+	MACAddress   string
+	NetworkID    int64
+	NetworkName  string
+	Network      *net.IPNet
+	Subnet       *net.IPNet
+	Gateway      net.IP
+}
+
+// Document represents the metadata document of the server, served at the root of the
+// Metadata endpoint.
+type Document struct {
+	Hostname         string
+	InstanceID       int64
+	PublicIPv4       net.IP
+	Region           string
+	AvailabilityZone string
+	PublicKeys       []string
+	// VendorData is the cloud-init vendor data provided by Hetzner.
+	VendorData string
+}
+
+// Instance represents all the metadata of the server, see [Client.Instance].
+type Instance struct {
+	Document
+	PrivateNetworks []PrivateNetwork
+	UserData        string
+}
+
+// ParsePrivateNetworks parses the private networks returned by
+// [Client.PrivateNetworksWithContext].
+func ParsePrivateNetworks(data string) ([]PrivateNetwork, error) {
+	var raw []schema.PrivateNetwork
+	if err := yaml.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse private networks: %w", err)
+	}
+
+	result := make([]PrivateNetwork, 0, len(raw))
+	for _, item := range raw {
+		privateNetwork := PrivateNetwork{
+			IP:           net.ParseIP(item.IP),
+			AliasIPs:     make([]net.IP, 0, len(item.AliasIPs)),
+			InterfaceNum: item.InterfaceNum,
+			MACAddress:   item.MACAddress,
+			NetworkID:    item.NetworkID,
+			NetworkName:  item.NetworkName,
+			Gateway:      net.ParseIP(item.Gateway),
+		}
+		for _, aliasIP := range item.AliasIPs {
+			privateNetwork.AliasIPs = append(privateNetwork.AliasIPs, net.ParseIP(aliasIP))
+		}
+
+		var err error
+		if privateNetwork.Network, err = parseIPNet(item.Network); err != nil {
+			return nil, fmt.Errorf("failed to parse private network %d: %w", item.NetworkID, err)
+		}
+		if privateNetwork.Subnet, err = parseIPNet(item.Subnet); err != nil {
+			return nil, fmt.Errorf("failed to parse private network %d: %w", item.NetworkID, err)
+		}
+
+		result = append(result, privateNetwork)
+	}
+	return result, nil
+}
+
+func parseIPNet(value string) (*net.IPNet, error) {
+	if value == "" {
+		return nil, nil
+	}
+	_, ipNet, err := net.ParseCIDR(value)
+	return ipNet, err
+}
+
+// ParsedPrivateNetworks returns the parsed details about the private networks the server
+// is attached to.
+func (c *Client) ParsedPrivateNetworks(ctx context.Context) ([]PrivateNetwork, error) {
+	data, err := c.PrivateNetworksWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivateNetworks(data)
+}
+
+// PublicKeys returns the public SSH keys of the server that did the request to the
+// Metadata server.
+func (c *Client) PublicKeys(ctx context.Context) ([]string, error) {
+	data, err := c.get(ctx, "/public-keys")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	if err := yaml.Unmarshal([]byte(data), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse public keys: %w", err)
+	}
+	return result, nil
+}
+
+// Document returns the metadata document of the server that did the request to the
+// Metadata server.
+func (c *Client) Document(ctx context.Context) (*Document, error) {
+	// The document is not trimmed, as it would alter a trailing block scalar.
+	data, err := c.getRaw(ctx, c.endpoint, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var raw schema.Document
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	return &Document{
+		Hostname:         raw.Hostname,
+		InstanceID:       raw.InstanceID,
+		PublicIPv4:       net.ParseIP(raw.PublicIPv4),
+		Region:           raw.Region,
+		AvailabilityZone: raw.AvailabilityZone,
+		PublicKeys:       raw.PublicKeys,
+		VendorData:       raw.VendorData,
+	}, nil
+}
+
+// VendorData returns the cloud-init vendor data of the server that did the request to
+// the Metadata server.
+//
+// Unlike the user data, the vendor data is not served at a dedicated endpoint (there is
+// no /vendordata), but in the vendor_data key of the metadata document. This is also
+// where the cloud-init Hetzner datasource reads it from.
+func (c *Client) VendorData(ctx context.Context) (string, error) {
+	document, err := c.Document(ctx)
+	if err != nil {
+		return "", err
+	}
+	return document.VendorData, nil
+}
+
+// UserData returns the user data of the server that did the request to the Metadata
+// server. The user data is returned unmodified.
+//
+// The user data is served next to the Metadata endpoint, for example
+// http://169.254.169.254/hetzner/v1/userdata.
+func (c *Client) UserData(ctx context.Context) (string, error) {
+	data, err := c.getRaw(ctx, strings.TrimSuffix(c.endpoint, "/metadata"), "/userdata")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Instance returns all the metadata of the server that did the request to the Metadata
+// server. The metadata document, private networks and user data are fetched
+// concurrently.
+func (c *Client) Instance(ctx context.Context) (*Instance, error) {
+	var (
+		document        *Document
+		privateNetworks []PrivateNetwork
+		userData        string
+
+		documentErr, privateNetworksErr, userDataErr error
+	)
+
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		document, documentErr = c.Document(ctx)
+	})
+	wg.Go(func() {
+		privateNetworks, privateNetworksErr = c.ParsedPrivateNetworks(ctx)
+	})
+	wg.Go(func() {
+		userData, userDataErr = c.UserData(ctx)
+	})
+	wg.Wait()
+
+	if err := errors.Join(documentErr, privateNetworksErr, userDataErr); err != nil {
+		return nil, err
+	}
+
+	return &Instance{
+		Document:        *document,
+		PrivateNetworks: privateNetworks,
+		UserData:        userData,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package metadata
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrivateNetworksYAML = `- ip: 10.0.0.2
+  alias_ips: [10.0.0.3, 10.0.0.4]
+  interface_num: 1
+  mac_address: 86:00:00:2a:7d:e0
+  network_id: 1234
+  network_name: nw-test1
+  network: 10.0.0.0/8
+  subnet: 10.0.0.0/24
+  gateway: 10.0.0.1`
+
+const testDocumentYAML = `availability-zone: fsn1-dc14
+hostname: my-server
+instance-id: 123456
+public-ipv4: 203.0.113.1
+public-keys:
+- ssh-ed25519 AAAA user@example.com
+region: eu-central
+vendor_data: "#cloud-config\n"`
+
+func mustParseCIDR(t *testing.T, value string) *net.IPNet {
+	t.Helper()
+
+	_, ipNet, err := net.ParseCIDR(value)
+	require.NoError(t, err)
+	return ipNet
+}
+
+func TestParsePrivateNetworks(t *testing.T) {
+	privateNetworks, err := ParsePrivateNetworks(testPrivateNetworksYAML)
+	require.NoError(t, err)
+	assert.Equal(t, []PrivateNetwork{{
+		IP:           net.ParseIP("10.0.0.2"),
+		AliasIPs:     []net.IP{net.ParseIP("10.0.0.3"), net.ParseIP("10.0.0.4")},
+		InterfaceNum: 1,
+		MACAddress:   "86:00:00:2a:7d:e0",
+		NetworkID:    1234,
+		NetworkName:  "nw-test1",
+		Network:      mustParseCIDR(t, "10.0.0.0/8"),
+		Subnet:       mustParseCIDR(t, "10.0.0.0/24"),
+		Gateway:      net.ParseIP("10.0.0.1"),
+	}}, privateNetworks)
+
+	privateNetworks, err = ParsePrivateNetworks("")
+	require.NoError(t, err)
+	assert.Empty(t, privateNetworks)
+
+	_, err = ParsePrivateNetworks("- network_id: 1\n  subnet: 10.0.0.0")
+	require.EqualError(t, err, "failed to parse private network 1: invalid CIDR address: 10.0.0.0")
+
+	_, err = ParsePrivateNetworks("invalid")
+	require.ErrorContains(t, err, "failed to parse private networks")
+}
+
+func TestClient_PublicKeys(t *testing.T) {
+	env := newTestEnv()
+	env.Mux.HandleFunc("/public-keys", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("- ssh-ed25519 AAAA user@example.com\n- ssh-rsa BBBB\n"))
+	})
+
+	publicKeys, err := env.Client.PublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ssh-ed25519 AAAA user@example.com", "ssh-rsa BBBB"}, publicKeys)
+}
+
+func TestClient_Document(t *testing.T) {
+	env := newTestEnv()
+	env.Mux.HandleFunc("/{$}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(testDocumentYAML))
+	})
+
+	document, err := env.Client.Document(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Document{
+		Hostname:         "my-server",
+		InstanceID:       123456,
+		PublicIPv4:       net.ParseIP("203.0.113.1"),
+		Region:           "eu-central",
+		AvailabilityZone: "fsn1-dc14",
+		PublicKeys:       []string{"ssh-ed25519 AAAA user@example.com"},
+		VendorData:       "#cloud-config\n",
+	}, document)
+
+	vendorData, err := env.Client.VendorData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", vendorData)
+}
+
+func TestClient_UserData(t *testing.T) {
+	env := newTestEnv()
+	env.Mux.HandleFunc("/hetzner/v1/userdata", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("#!/bin/sh\necho hello\n"))
+	})
+
+	client := NewClient(WithEndpoint(env.Server.URL + "/hetzner/v1/metadata"))
+
+	// The user data is not trimmed
+	userData, err := client.UserData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho hello\n", userData)
+}
+
+func TestClient_Instance(t *testing.T) {
+	env := newTestEnv()
+	env.Mux.HandleFunc("/{$}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(testDocumentYAML))
+	})
+	env.Mux.HandleFunc("/private-networks", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(testPrivateNetworksYAML))
+	})
+	env.Mux.HandleFunc("/userdata", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("#cloud-config\n"))
+	})
+
+	instance, err := env.Client.Instance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), instance.InstanceID)
+	assert.Equal(t, "my-server", instance.Hostname)
+	assert.Len(t, instance.PrivateNetworks, 1)
+	assert.Equal(t, "#cloud-config\n", instance.UserData)
+
+	t.Run("error", func(t *testing.T) {
+		env := newTestEnv()
+		env.Mux.HandleFunc("/{$}", func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(testDocumentYAML))
+		})
+
+		_, err := env.Client.Instance(context.Background())
+		require.EqualError(t, err, "response status was 404\nresponse status was 404")
+	})
+}
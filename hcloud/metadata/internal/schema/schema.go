@@ -0,0 +1,27 @@
+// Package schema defines the YAML representations of the Metadata server responses,
+// shared by the metadata client and its fake server.
+package schema
+
+// Document is the metadata document served at the root of the Metadata endpoint.
+type Document struct {
+	Hostname         string   `yaml:"hostname"`
+	InstanceID       int64    `yaml:"instance-id"`
+	PublicIPv4       string   `yaml:"public-ipv4"`
+	Region           string   `yaml:"region"`
+	AvailabilityZone string   `yaml:"availability-zone"`
+	PublicKeys       []string `yaml:"public-keys"`
+	VendorData       string   `yaml:"vendor_data"`
+}
+
+// PrivateNetwork is a private network served by the private-networks endpoint.
+type PrivateNetwork struct {
+	IP           string   `yaml:"ip"`
+	AliasIPs     []string `yaml:"alias_ips"`
+	InterfaceNum int      `yaml:"interface_num"`
+	MACAddress   string   `yaml:"mac_address"`
+	NetworkID    int64    `yaml:"network_id"`
+	NetworkName  string   `yaml:"network_name"`
+	Network      string   `yaml:"network"`
+	Subnet       string   `yaml:"subnet"`
+	Gateway      string   `yaml:"gateway"`
+}
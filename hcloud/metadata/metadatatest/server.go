@@ -0,0 +1,174 @@
+// Package metadatatest provides a fake of the Hetzner Cloud Server Metadata endpoints, to
+// test programs using the [metadata.Client].
+package metadatatest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata/internal/schema"
+)
+
+// MetadataPath is the path of the Metadata endpoint served by the [Server].
+const MetadataPath = "/hetzner/v1/metadata"
+
+// UserDataPath is the path of the user data served by the [Server].
+const UserDataPath = "/hetzner/v1/userdata"
+
+// Server is a fake of the Hetzner Cloud Server Metadata endpoints, serving the metadata
+// of a single server.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	instance metadata.Instance
+	failures []int
+	requests map[string]int
+}
+
+// NewServer returns a new fake server serving the metadata of the instance, that closes
+// itself at the end of the test.
+func NewServer(t *testing.T, instance metadata.Instance) *Server {
+	t.Helper()
+
+	s := New(instance)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// New returns a new fake server serving the metadata of the instance. The caller is
+// responsible to close the server.
+func New(instance metadata.Instance) *Server {
+	s := &Server{
+		instance: instance,
+		requests: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+MetadataPath, s.handleDocument)
+	mux.HandleFunc("GET "+MetadataPath+"/hostname", s.handleValue(func(i metadata.Instance) string { return i.Hostname }))
+	mux.HandleFunc("GET "+MetadataPath+"/instance-id", s.handleValue(func(i metadata.Instance) string { return strconv.FormatInt(i.InstanceID, 10) }))
+	mux.HandleFunc("GET "+MetadataPath+"/public-ipv4", s.handleValue(func(i metadata.Instance) string { return ipString(i.PublicIPv4) }))
+	mux.HandleFunc("GET "+MetadataPath+"/region", s.handleValue(func(i metadata.Instance) string { return i.Region }))
+	mux.HandleFunc("GET "+MetadataPath+"/availability-zone", s.handleValue(func(i metadata.Instance) string { return i.AvailabilityZone }))
+	mux.HandleFunc("GET "+MetadataPath+"/public-keys", s.handleYAML(func(i metadata.Instance) any { return nonNil(i.PublicKeys) }))
+	mux.HandleFunc("GET "+MetadataPath+"/private-networks", s.handleYAML(func(i metadata.Instance) any { return privateNetworksToYAML(i.PrivateNetworks) }))
+	mux.HandleFunc("GET "+UserDataPath, s.handleValue(func(i metadata.Instance) string { return i.UserData }))
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Endpoint returns the Metadata endpoint of the server, to configure a
+// [metadata.Client] with [metadata.WithEndpoint].
+func (s *Server) Endpoint() string {
+	return s.URL + MetadataPath
+}
+
+// Client returns a [metadata.Client] using the server, with the options applied.
+func (s *Server) Client(options ...metadata.ClientOption) *metadata.Client {
+	return metadata.NewClient(append([]metadata.ClientOption{metadata.WithEndpoint(s.Endpoint())}, options...)...)
+}
+
+// SetInstance replaces the metadata served by the server.
+func (s *Server) SetInstance(instance metadata.Instance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.instance = instance
+}
+
+// Fail makes the next requests fail with the given status codes, one status code per
+// request, in order.
+func (s *Server) Fail(statusCodes ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, statusCodes...)
+}
+
+// Requests returns the number of requests received for the path, including the failed
+// ones. The path is relative to the Metadata endpoint, for example "/hostname", except
+// for the user data which is served at [UserDataPath].
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[path]
+}
+
+// begin records the request, and returns the instance to serve, or a status code to fail
+// the request with.
+func (s *Server) begin(r *http.Request) (metadata.Instance, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := r.URL.Path
+	if path != UserDataPath {
+		path = path[len(MetadataPath):]
+	}
+	s.requests[path]++
+
+	if len(s.failures) > 0 {
+		statusCode := s.failures[0]
+		s.failures = s.failures[1:]
+		return metadata.Instance{}, statusCode
+	}
+	return s.instance, 0
+}
+
+func (s *Server) handleValue(value func(metadata.Instance) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		instance, statusCode := s.begin(r)
+		if statusCode != 0 {
+			http.Error(w, http.StatusText(statusCode), statusCode)
+			return
+		}
+		_, _ = w.Write([]byte(value(instance)))
+	}
+}
+
+func (s *Server) handleYAML(value func(metadata.Instance) any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		instance, statusCode := s.begin(r)
+		if statusCode != 0 {
+			http.Error(w, http.StatusText(statusCode), statusCode)
+			return
+		}
+		writeYAML(w, value(instance))
+	}
+}
+
+func (s *Server) handleDocument(w http.ResponseWriter, r *http.Request) {
+	instance, statusCode := s.begin(r)
+	if statusCode != 0 {
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+
+	writeYAML(w, schema.Document{
+		Hostname:         instance.Hostname,
+		InstanceID:       instance.InstanceID,
+		PublicIPv4:       ipString(instance.PublicIPv4),
+		Region:           instance.Region,
+		AvailabilityZone: instance.AvailabilityZone,
+		PublicKeys:       nonNil(instance.PublicKeys),
+		VendorData:       instance.VendorData,
+	})
+}
+
+func writeYAML(w http.ResponseWriter, value any) {
+	body, err := yaml.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,148 @@
+package metadatatest
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata"
+)
+
+func testInstance(t *testing.T) metadata.Instance {
+	t.Helper()
+
+	_, network, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, subnet, err := net.ParseCIDR("10.0.0.0/24")
+	require.NoError(t, err)
+
+	return metadata.Instance{
+		Document: metadata.Document{
+			Hostname:         "my-server",
+			InstanceID:       42,
+			PublicIPv4:       net.ParseIP("203.0.113.1"),
+			Region:           "eu-central",
+			AvailabilityZone: "fsn1-dc14",
+			PublicKeys:       []string{"ssh-ed25519 AAAA user@example.com"},
+			VendorData:       "#cloud-config\n",
+		},
+		PrivateNetworks: []metadata.PrivateNetwork{{
+			IP:           net.ParseIP("10.0.0.2"),
+			AliasIPs:     []net.IP{net.ParseIP("10.0.0.3")},
+			InterfaceNum: 1,
+			MACAddress:   "86:00:00:2a:7d:e0",
+			NetworkID:    1234,
+			NetworkName:  "nw-test1",
+			Network:      network,
+			Subnet:       subnet,
+			Gateway:      net.ParseIP("10.0.0.1"),
+		}},
+		UserData: "#!/bin/sh\necho hello\n",
+	}
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	instance := testInstance(t)
+	server := NewServer(t, instance)
+	client := server.Client()
+
+	result, err := client.Instance(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &instance, result)
+
+	hostname, err := client.HostnameWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", hostname)
+
+	instanceID, err := client.InstanceIDWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), instanceID)
+
+	publicIPv4, err := client.PublicIPv4WithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.1", publicIPv4.String())
+
+	region, err := client.RegionWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-central", region)
+
+	availabilityZone, err := client.AvailabilityZoneWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "fsn1-dc14", availabilityZone)
+
+	publicKeys, err := client.PublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, instance.PublicKeys, publicKeys)
+
+	// The vendor data is read from the metadata document
+	documentRequests := server.Requests("")
+	vendorData, err := client.VendorData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", vendorData)
+	assert.Equal(t, documentRequests+1, server.Requests(""))
+
+	assert.True(t, client.IsHcloudServerWithContext(ctx))
+
+	// The served metadata can be replaced
+	server.SetInstance(metadata.Instance{})
+
+	publicKeys, err = client.PublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, publicKeys)
+	assert.False(t, client.IsHcloudServerWithContext(ctx))
+}
+
+func TestServerFail(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer(t, testInstance(t))
+
+	server.Fail(http.StatusServiceUnavailable)
+	_, err := server.Client().HostnameWithContext(ctx)
+	require.EqualError(t, err, "response status was 503")
+
+	// Failed requests are retried
+	client := server.Client(metadata.WithRetryOpts(metadata.RetryOpts{
+		MaxRetries:  2,
+		BackoffFunc: func(int) time.Duration { return 0 },
+	}))
+
+	server.Fail(http.StatusInternalServerError, http.StatusTooManyRequests)
+	hostname, err := client.HostnameWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", hostname)
+	assert.Equal(t, 4, server.Requests("/hostname"))
+
+	// Client errors are not retried
+	server.Fail(http.StatusNotFound)
+	_, err = client.HostnameWithContext(ctx)
+	require.EqualError(t, err, "response status was 404")
+	assert.Equal(t, 5, server.Requests("/hostname"))
+}
+
+func TestServerWithCache(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer(t, testInstance(t))
+	client := server.Client(metadata.WithCache(time.Minute))
+
+	for range 3 {
+		userData, err := client.UserData(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "#!/bin/sh\necho hello\n", userData)
+	}
+	assert.Equal(t, 1, server.Requests(UserDataPath))
+
+	client.ClearCache()
+
+	_, err := client.UserData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.Requests(UserDataPath))
+}
@@ -0,0 +1,51 @@
+package metadatatest
+
+import (
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata/internal/schema"
+)
+
+func privateNetworksToYAML(privateNetworks []metadata.PrivateNetwork) []schema.PrivateNetwork {
+	result := make([]schema.PrivateNetwork, 0, len(privateNetworks))
+	for _, privateNetwork := range privateNetworks {
+		item := schema.PrivateNetwork{
+			IP:           ipString(privateNetwork.IP),
+			AliasIPs:     make([]string, 0, len(privateNetwork.AliasIPs)),
+			InterfaceNum: privateNetwork.InterfaceNum,
+			MACAddress:   privateNetwork.MACAddress,
+			NetworkID:    privateNetwork.NetworkID,
+			NetworkName:  privateNetwork.NetworkName,
+			Network:      ipNetString(privateNetwork.Network),
+			Subnet:       ipNetString(privateNetwork.Subnet),
+			Gateway:      ipString(privateNetwork.Gateway),
+		}
+		for _, aliasIP := range privateNetwork.AliasIPs {
+			item.AliasIPs = append(item.AliasIPs, aliasIP.String())
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func ipString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+func ipNetString(ipNet *net.IPNet) string {
+	if ipNet == nil {
+		return ""
+	}
+	return ipNet.String()
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -173,6 +174,19 @@ func (c *NetworkClient) AllWithOpts(ctx context.Context, opts NetworkListOpts) (
 	})
 }
 
+// Iter returns an iterator over all networks for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *NetworkClient) Iter(ctx context.Context, opts NetworkListOpts) iter.Seq2[*Network, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Network, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // Delete deletes a network.
 func (c *NetworkClient) Delete(ctx context.Context, network *Network) (*Response, error) {
 	const opPath = "/networks/%d"
@@ -244,6 +258,7 @@ func (o NetworkCreateOpts) Validate() error {
 func (c *NetworkClient) Create(ctx context.Context, opts NetworkCreateOpts) (*Network, *Response, error) {
 	const opPath = "/networks"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	reqPath := opPath
 
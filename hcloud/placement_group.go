@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -120,6 +121,19 @@ func (c *PlacementGroupClient) AllWithOpts(ctx context.Context, opts PlacementGr
 	})
 }
 
+// Iter returns an iterator over all PlacementGroups for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *PlacementGroupClient) Iter(ctx context.Context, opts PlacementGroupListOpts) iter.Seq2[*PlacementGroup, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*PlacementGroup, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // PlacementGroupCreateOpts specifies options for creating a new PlacementGroup.
 type PlacementGroupCreateOpts struct {
 	Name   string
@@ -145,6 +159,7 @@ type PlacementGroupCreateResult struct {
 func (c *PlacementGroupClient) Create(ctx context.Context, opts PlacementGroupCreateOpts) (PlacementGroupCreateResult, *Response, error) {
 	const opPath = "/placement_groups"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := PlacementGroupCreateResult{}
 
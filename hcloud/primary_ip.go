@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -258,10 +259,24 @@ func (c *PrimaryIPClient) AllWithOpts(ctx context.Context, opts PrimaryIPListOpt
 	})
 }
 
+// Iter returns an iterator over all Primary IPs for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *PrimaryIPClient) Iter(ctx context.Context, opts PrimaryIPListOpts) iter.Seq2[*PrimaryIP, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*PrimaryIP, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // Create creates a Primary IP.
 func (c *PrimaryIPClient) Create(ctx context.Context, opts PrimaryIPCreateOpts) (*PrimaryIPCreateResult, *Response, error) {
 	const opPath = "/primary_ips"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := &PrimaryIPCreateResult{}
 
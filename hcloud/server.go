@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"slices"
@@ -295,6 +296,19 @@ func (c *ServerClient) AllWithOpts(ctx context.Context, opts ServerListOpts) ([]
 	})
 }
 
+// Iter returns an iterator over all servers for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ServerClient) Iter(ctx context.Context, opts ServerListOpts) iter.Seq2[*Server, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Server, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // ServerCreateOpts specifies options for creating a new server.
 type ServerCreateOpts struct {
 	Name             string
@@ -351,6 +365,7 @@ type ServerCreateResult struct {
 func (c *ServerClient) Create(ctx context.Context, opts ServerCreateOpts) (ServerCreateResult, *Response, error) {
 	const opPath = "/servers"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := ServerCreateResult{}
 
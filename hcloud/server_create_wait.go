@@ -0,0 +1,195 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ServerCreateStep is a step of [ServerClient.CreateAndWait].
+type ServerCreateStep string
+
+const (
+	// ServerCreateStepCreating is reported before the server is created.
+	ServerCreateStepCreating ServerCreateStep = "creating"
+	// ServerCreateStepWaitingForActions is reported while waiting for the actions
+	// triggered by the creation of the server.
+	ServerCreateStepWaitingForActions ServerCreateStep = "waiting_for_actions"
+	// ServerCreateStepWaitingForStatus is reported while waiting for the server to
+	// reach its final status.
+	ServerCreateStepWaitingForStatus ServerCreateStep = "waiting_for_status"
+	// ServerCreateStepRollingBack is reported before the half-created server is deleted.
+	ServerCreateStepRollingBack ServerCreateStep = "rolling_back"
+	// ServerCreateStepReady is reported once the server is ready.
+	ServerCreateStepReady ServerCreateStep = "ready"
+)
+
+// ServerCreateProgress is reported by [ServerClient.CreateAndWait] to follow the
+// creation of a server.
+type ServerCreateProgress struct {
+	Step ServerCreateStep
+	// Server is the last known state of the server, nil until the server is created.
+	Server *Server
+	// Action is the action that was updated, only set during
+	// [ServerCreateStepWaitingForActions].
+	Action *Action
+}
+
+// ServerCreateAndWaitOpts specifies options for [ServerClient.CreateAndWait].
+type ServerCreateAndWaitOpts struct {
+	// Rollback deletes the server, and the primary IPs allocated for it, when a step
+	// after the creation of the server fails.
+	Rollback bool
+	// OnProgress is called for every progress update.
+	OnProgress func(progress ServerCreateProgress)
+}
+
+// CreateAndWait creates a server, waits for the actions triggered by its creation,
+// and waits until the server is running (or off, when [ServerCreateOpts.StartAfterCreate]
+// is false).
+//
+// The returned result holds the reloaded server. If a step fails after the server was
+// created, the result holds the last known state of the server and, when
+// [ServerCreateAndWaitOpts.Rollback] is set, the server and the primary IPs allocated
+// for it are deleted. The rollback is not canceled with the context.
+//
+// CreateAndWait uses the [WithPollOpts] of the [Client] to wait until sending the next
+// request.
+func (c *ServerClient) CreateAndWait(ctx context.Context, opts ServerCreateOpts, waitOpts ServerCreateAndWaitOpts) (ServerCreateResult, *Response, error) {
+	progress := func(step ServerCreateStep, server *Server, action *Action) {
+		if waitOpts.OnProgress != nil {
+			waitOpts.OnProgress(ServerCreateProgress{Step: step, Server: server, Action: action})
+		}
+	}
+
+	progress(ServerCreateStepCreating, nil, nil)
+
+	result, resp, err := c.Create(ctx, opts)
+	if err != nil {
+		return result, resp, err
+	}
+
+	// Primary IPs passed in the options are owned by the caller and must not be
+	// deleted during the rollback.
+	allocatedPrimaryIPs := allocatedPrimaryIPIDs(result.Server, opts.PublicNet)
+
+	resp, err = c.waitUntilReady(ctx, &result, opts, progress)
+	if err != nil {
+		if waitOpts.Rollback {
+			progress(ServerCreateStepRollingBack, result.Server, nil)
+
+			if rollbackErr := c.rollbackCreate(context.WithoutCancel(ctx), result.Server, allocatedPrimaryIPs); rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("rollback: %w", rollbackErr))
+			}
+		}
+		return result, resp, err
+	}
+
+	progress(ServerCreateStepReady, result.Server, nil)
+
+	return result, resp, nil
+}
+
+func (c *ServerClient) waitUntilReady(
+	ctx context.Context,
+	result *ServerCreateResult,
+	opts ServerCreateOpts,
+	progress func(step ServerCreateStep, server *Server, action *Action),
+) (*Response, error) {
+	actions := make([]*Action, 0, 1+len(result.NextActions))
+	if result.Action != nil {
+		actions = append(actions, result.Action)
+	}
+	actions = append(actions, result.NextActions...)
+
+	progress(ServerCreateStepWaitingForActions, result.Server, nil)
+
+	err := c.client.Action.WaitForFunc(ctx, func(update *Action) error {
+		progress(ServerCreateStepWaitingForActions, result.Server, update)
+		if update.Status == ActionStatusError {
+			return update.Error()
+		}
+		return nil
+	}, actions...)
+	if err != nil {
+		return nil, err
+	}
+
+	status := ServerStatusRunning
+	if opts.StartAfterCreate != nil && !*opts.StartAfterCreate {
+		status = ServerStatusOff
+	}
+
+	for retries := 0; ; retries++ {
+		server, resp, err := c.GetByID(ctx, result.Server.ID)
+		if err != nil {
+			return resp, err
+		}
+		if server == nil {
+			return resp, fmt.Errorf("server not found: %d", result.Server.ID)
+		}
+		result.Server = server
+
+		progress(ServerCreateStepWaitingForStatus, server, nil)
+
+		if server.Status == status {
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, fmt.Errorf("%w: server %d has status %s, expected %s", ctx.Err(), server.ID, server.Status, status)
+		case <-time.After(c.client.pollBackoffFunc(retries)):
+		}
+	}
+}
+
+func (c *ServerClient) rollbackCreate(ctx context.Context, server *Server, primaryIPIDs []int64) error {
+	deleteResult, _, err := c.DeleteWithResult(ctx, server)
+	if err != nil && !IsError(err, ErrorCodeNotFound) {
+		return err
+	}
+	if deleteResult != nil && deleteResult.Action != nil {
+		if err := c.client.Action.WaitFor(ctx, deleteResult.Action); err != nil {
+			return err
+		}
+	}
+
+	// Primary IPs with auto delete are deleted with the server, only delete the
+	// remaining ones.
+	for _, id := range primaryIPIDs {
+		primaryIP, _, err := c.client.PrimaryIP.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if primaryIP == nil {
+			continue
+		}
+		if _, err := c.client.PrimaryIP.Delete(ctx, primaryIP); err != nil && !IsError(err, ErrorCodeNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// allocatedPrimaryIPIDs returns the IDs of the primary IPs of the server that were
+// not passed in the create options.
+func allocatedPrimaryIPIDs(server *Server, publicNet *ServerCreatePublicNet) []int64 {
+	if server == nil {
+		return nil
+	}
+
+	owned := func(primaryIP *PrimaryIP, id int64) bool {
+		return primaryIP != nil && primaryIP.ID == id
+	}
+
+	ids := make([]int64, 0, 2)
+	if id := server.PublicNet.IPv4.ID; id != 0 && (publicNet == nil || !owned(publicNet.IPv4, id)) {
+		ids = append(ids, id)
+	}
+	if id := server.PublicNet.IPv6.ID; id != 0 && (publicNet == nil || !owned(publicNet.IPv6, id)) {
+		ids = append(ids, id)
+	}
+	return ids
+}
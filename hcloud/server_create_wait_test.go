@@ -0,0 +1,173 @@
+package hcloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+func TestServerClientCreateAndWait(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "POST", Path: "/servers",
+			Status: 201,
+			JSONRaw: `{
+				"server": { "id": 1, "status": "initializing" },
+				"action": { "id": 10, "status": "running" },
+				"next_actions": [{ "id": 11, "status": "running" }],
+				"root_password": "secret"
+			}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=10&id=11&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [
+					{ "id": 10, "status": "success", "progress": 100 },
+					{ "id": 11, "status": "success", "progress": 100 }
+				],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1, "status": "starting" }}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "server": { "id": 1, "status": "running" }}`,
+		},
+	})
+
+	steps := make([]ServerCreateStep, 0)
+	result, _, err := client.Server.CreateAndWait(ctx,
+		ServerCreateOpts{Name: "web-1", ServerType: &ServerType{Name: "cpx22"}, Image: &Image{Name: "ubuntu-24.04"}},
+		ServerCreateAndWaitOpts{
+			OnProgress: func(progress ServerCreateProgress) {
+				steps = append(steps, progress.Step)
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ServerStatusRunning, result.Server.Status)
+	assert.Equal(t, "secret", result.RootPassword)
+	assert.Equal(t, []ServerCreateStep{
+		ServerCreateStepCreating,
+		ServerCreateStepWaitingForActions,
+		ServerCreateStepWaitingForActions,
+		ServerCreateStepWaitingForActions,
+		ServerCreateStepWaitingForStatus,
+		ServerCreateStepWaitingForStatus,
+		ServerCreateStepReady,
+	}, steps)
+}
+
+func TestServerClientCreateAndWaitRollback(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "POST", Path: "/servers",
+			Status: 201,
+			JSONRaw: `{
+				"server": {
+					"id": 1,
+					"status": "initializing",
+					"public_net": {
+						"ipv4": { "id": 20, "ip": "203.0.113.1" },
+						"ipv6": { "id": 21, "ip": "2001:db8::/64" }
+					}
+				},
+				"action": { "id": 10, "status": "running" }
+			}`,
+		},
+		{
+			Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [{
+					"id": 10,
+					"status": "error",
+					"error": { "code": "action_failed", "message": "Action failed" }
+				}],
+				"meta": { "pagination": { "page": 1 }}
+			}`,
+		},
+		{
+			Method: "DELETE", Path: "/servers/1",
+			Status:  200,
+			JSONRaw: `{ "action": { "id": 12, "status": "success" }}`,
+		},
+		{
+			Method: "GET", Path: "/primary_ips/20",
+			Status:  200,
+			JSONRaw: `{ "primary_ip": { "id": 20, "auto_delete": false }}`,
+		},
+		{
+			Method: "DELETE", Path: "/primary_ips/20",
+			Status: 204,
+		},
+	})
+
+	steps := make([]ServerCreateStep, 0)
+	result, _, err := client.Server.CreateAndWait(ctx,
+		ServerCreateOpts{
+			Name:       "web-1",
+			ServerType: &ServerType{Name: "cpx22"},
+			Image:      &Image{Name: "ubuntu-24.04"},
+			PublicNet:  &ServerCreatePublicNet{EnableIPv4: true, EnableIPv6: true, IPv6: &PrimaryIP{ID: 21}},
+		},
+		ServerCreateAndWaitOpts{
+			Rollback: true,
+			OnProgress: func(progress ServerCreateProgress) {
+				steps = append(steps, progress.Step)
+			},
+		},
+	)
+	require.EqualError(t, err, "Action failed (action_failed, 10)")
+	assert.Equal(t, int64(1), result.Server.ID)
+	assert.Equal(t, []ServerCreateStep{
+		ServerCreateStepCreating,
+		ServerCreateStepWaitingForActions,
+		ServerCreateStepWaitingForActions,
+		ServerCreateStepRollingBack,
+	}, steps)
+}
+
+func TestServerClientCreateAndWaitRollbackError(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "POST", Path: "/servers",
+			Status: 201,
+			JSONRaw: `{
+				"server": { "id": 1, "status": "initializing" },
+				"action": { "id": 10, "status": "success" }
+			}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  404,
+			JSONRaw: `{ "error": { "code": "not_found", "message": "server not found" }}`,
+		},
+		{
+			Method: "DELETE", Path: "/servers/1",
+			Status:  423,
+			JSONRaw: `{ "error": { "code": "locked", "message": "server is locked" }}`,
+		},
+	})
+
+	_, _, err := client.Server.CreateAndWait(ctx,
+		ServerCreateOpts{Name: "web-1", ServerType: &ServerType{Name: "cpx22"}, Image: &Image{Name: "ubuntu-24.04"}},
+		ServerCreateAndWaitOpts{Rollback: true},
+	)
+	require.EqualError(t, err, "server not found: 1\nrollback: server is locked (locked)")
+}
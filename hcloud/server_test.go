@@ -501,6 +501,95 @@ func TestServersAllWithOpts(t *testing.T) {
 	}
 }
 
+func TestServerClientIter(t *testing.T) {
+	t.Run("all pages", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers?label_selector=key%3Dvalue&page=1&per_page=50",
+				Status: 200,
+				JSONRaw: `{
+					"servers": [{ "id": 1 }, { "id": 2 }],
+					"meta": { "pagination": { "page": 1, "next_page": 2 }}
+				}`,
+			},
+			{
+				Method: "GET", Path: "/servers?label_selector=key%3Dvalue&page=2&per_page=50",
+				Status: 200,
+				JSONRaw: `{
+					"servers": [{ "id": 3 }],
+					"meta": { "pagination": { "page": 2 }}
+				}`,
+			},
+		})
+
+		ids := []int64{}
+		for server, err := range client.Server.Iter(ctx, ServerListOpts{ListOpts: ListOpts{LabelSelector: "key=value"}}) {
+			require.NoError(t, err)
+			ids = append(ids, server.ID)
+		}
+		require.Equal(t, []int64{1, 2, 3}, ids)
+	})
+
+	t.Run("break before next page", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers?page=1&per_page=50",
+				Status: 200,
+				JSONRaw: `{
+					"servers": [{ "id": 1 }, { "id": 2 }],
+					"meta": { "pagination": { "page": 1, "next_page": 2 }}
+				}`,
+			},
+		})
+
+		ids := []int64{}
+		for server, err := range client.Server.Iter(ctx, ServerListOpts{}) {
+			require.NoError(t, err)
+			ids = append(ids, server.ID)
+			if len(ids) == 2 {
+				break
+			}
+		}
+		require.Equal(t, []int64{1, 2}, ids)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers?page=1&per_page=50",
+				Status: 200,
+				JSONRaw: `{
+					"servers": [{ "id": 1 }],
+					"meta": { "pagination": { "page": 1, "next_page": 2 }}
+				}`,
+			},
+			{
+				Method: "GET", Path: "/servers?page=2&per_page=50",
+				Status:  403,
+				JSONRaw: `{ "error": { "code": "forbidden", "message": "forbidden" }}`,
+			},
+		})
+
+		ids := []int64{}
+		var iterErr error
+		for server, err := range client.Server.Iter(ctx, ServerListOpts{}) {
+			if err != nil {
+				iterErr = err
+				break
+			}
+			ids = append(ids, server.ID)
+		}
+		require.Equal(t, []int64{1}, ids)
+		require.True(t, IsError(iterErr, ErrorCodeForbidden))
+	})
+}
+
 func TestServersCreateWithSSHKeys(t *testing.T) {
 	env := newTestEnv()
 	defer env.Teardown()
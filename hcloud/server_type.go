@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -154,3 +155,16 @@ func (c *ServerTypeClient) AllWithOpts(ctx context.Context, opts ServerTypeListO
 		return c.List(ctx, opts)
 	})
 }
+
+// Iter returns an iterator over all server types for the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *ServerTypeClient) Iter(ctx context.Context, opts ServerTypeListOpts) iter.Seq2[*ServerType, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ServerType, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -119,6 +120,19 @@ func (c *SSHKeyClient) AllWithOpts(ctx context.Context, opts SSHKeyListOpts) ([]
 	})
 }
 
+// Iter returns an iterator over all SSH keys with the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *SSHKeyClient) Iter(ctx context.Context, opts SSHKeyListOpts) iter.Seq2[*SSHKey, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*SSHKey, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // SSHKeyCreateOpts specifies parameters for creating a SSH key.
 type SSHKeyCreateOpts struct {
 	Name      string
@@ -141,6 +155,7 @@ func (o SSHKeyCreateOpts) Validate() error {
 func (c *SSHKeyClient) Create(ctx context.Context, opts SSHKeyCreateOpts) (*SSHKey, *Response, error) {
 	const opPath = "/ssh_keys"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	reqPath := opPath
 
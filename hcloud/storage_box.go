@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -192,6 +193,21 @@ func (c *StorageBoxClient) AllWithOpts(ctx context.Context, opts StorageBoxListO
 	})
 }
 
+// Iter returns an iterator over all [StorageBox] with the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
+func (c *StorageBoxClient) Iter(ctx context.Context, opts StorageBoxListOpts) iter.Seq2[*StorageBox, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*StorageBox, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // StorageBoxCreateOpts specifies parameters for creating a [StorageBox].
 type StorageBoxCreateOpts struct {
 	Name           string
@@ -239,6 +255,7 @@ type StorageBoxCreateResult struct {
 func (c *StorageBoxClient) Create(ctx context.Context, opts StorageBoxCreateOpts) (StorageBoxCreateResult, *Response, error) {
 	const opPath = "/storage_boxes"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := StorageBoxCreateResult{}
 
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -145,6 +146,21 @@ func (c *StorageBoxClient) AllSnapshotsWithOpts(
 	return snapshots, err
 }
 
+// IterSnapshots returns an iterator over all [StorageBoxSnapshot] of a [StorageBox] with the given options.
+//
+// Pagination is not supported, so all [StorageBoxSnapshot] are fetched at once when iterating.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
+func (c *StorageBoxClient) IterSnapshots(
+	ctx context.Context,
+	storageBox *StorageBox,
+	opts StorageBoxSnapshotListOpts,
+) iter.Seq2[*StorageBoxSnapshot, error] {
+	return iterPagesSeq(ctx, func(_ int) ([]*StorageBoxSnapshot, *Response, error) {
+		return c.ListSnapshots(ctx, storageBox, opts)
+	})
+}
+
 // AllSnapshots lists all [StorageBoxSnapshot] of a [StorageBox] without any options.
 //
 // See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
@@ -177,6 +177,29 @@ func TestStorageBoxClientListSnapshot(t *testing.T) {
 
 		assert.Equal(t, int64(42), snapshots[0].ID)
 	})
+
+	t.Run("IterSnapshots", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/storage_boxes/42/snapshots?name=my-resource",
+				Status: 200,
+				JSONRaw: `{
+					"snapshots": [{ "id": 42 }, { "id": 43 }]
+				}`,
+			},
+		})
+
+		storageBox := &StorageBox{ID: 42}
+
+		ids := []int64{}
+		for snapshot, err := range client.StorageBox.IterSnapshots(ctx, storageBox, StorageBoxSnapshotListOpts{Name: "my-resource"}) {
+			require.NoError(t, err)
+			ids = append(ids, snapshot.ID)
+		}
+		assert.Equal(t, []int64{42, 43}, ids)
+	})
 }
 
 func TestStorageBoxClientCreateSnapshot(t *testing.T) {
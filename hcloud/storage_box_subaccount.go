@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -169,6 +170,21 @@ func (c *StorageBoxClient) AllSubaccountsWithOpts(
 	return subaccounts, err
 }
 
+// IterSubaccounts returns an iterator over all [StorageBoxSubaccount] of a [StorageBox] with the given options.
+//
+// Pagination is not supported, so all [StorageBoxSubaccount] are fetched at once when iterating.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
+func (c *StorageBoxClient) IterSubaccounts(
+	ctx context.Context,
+	storageBox *StorageBox,
+	opts StorageBoxSubaccountListOpts,
+) iter.Seq2[*StorageBoxSubaccount, error] {
+	return iterPagesSeq(ctx, func(_ int) ([]*StorageBoxSubaccount, *Response, error) {
+		return c.ListSubaccounts(ctx, storageBox, opts)
+	})
+}
+
 // AllSubaccounts retrieves all [StorageBoxSubaccount] of a [StorageBox].
 //
 // See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
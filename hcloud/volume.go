@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -147,6 +148,19 @@ func (c *VolumeClient) AllWithOpts(ctx context.Context, opts VolumeListOpts) ([]
 	})
 }
 
+// Iter returns an iterator over all volumes with the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+func (c *VolumeClient) Iter(ctx context.Context, opts VolumeListOpts) iter.Seq2[*Volume, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Volume, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // VolumeCreateOpts specifies parameters for creating a volume.
 type VolumeCreateOpts struct {
 	Name      string
@@ -189,6 +203,7 @@ type VolumeCreateResult struct {
 func (c *VolumeClient) Create(ctx context.Context, opts VolumeCreateOpts) (VolumeCreateResult, *Response, error) {
 	const opPath = "/volumes"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := VolumeCreateResult{}
 
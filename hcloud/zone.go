@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -217,6 +218,21 @@ func (c *ZoneClient) AllWithOpts(ctx context.Context, opts ZoneListOpts) ([]*Zon
 	})
 }
 
+// Iter returns an iterator over all [Zone] with the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+//
+// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
+func (c *ZoneClient) Iter(ctx context.Context, opts ZoneListOpts) iter.Seq2[*Zone, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Zone, *Response, error) {
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
 // ZoneCreateOpts defines options for creating a [Zone].
 type ZoneCreateOpts struct {
 	Name   string
@@ -260,6 +276,7 @@ type ZoneCreateResult struct {
 func (c *ZoneClient) Create(ctx context.Context, opts ZoneCreateOpts) (ZoneCreateResult, *Response, error) {
 	const opPath = "/zones"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
+	ctx = reconcileByName(ctx, opts.Name, c.GetByName)
 
 	result := ZoneCreateResult{}
 
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strings"
 
@@ -172,6 +173,21 @@ func (c *ZoneClient) AllRRSetsWithOpts(ctx context.Context, zone *Zone, opts Zon
 	})
 }
 
+// IterRRSets returns an iterator over all [ZoneRRSet] with the given options.
+//
+// Pages are fetched lazily while iterating, and iterating stops on the first error.
+//
+// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
+func (c *ZoneClient) IterRRSets(ctx context.Context, zone *Zone, opts ZoneRRSetListOpts) iter.Seq2[*ZoneRRSet, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ZoneRRSet, *Response, error) {
+		opts.Page = page
+		return c.ListRRSets(ctx, zone, opts)
+	})
+}
+
 // AllRRSets returns a list of all [ZoneRRSet].
 //
 // See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
@@ -4,11 +4,15 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IActionClient ...
 type IActionClient interface {
 	// GetByID retrieves an action by its ID. If the action does not exist, nil is returned.
+	//
+	// When the client is configured with [WithRequestCoalescing], concurrent calls are
+	// batched in a single request.
 	GetByID(ctx context.Context, id int64) (*Action, *Response, error)
 	// List returns a paginated list of actions.
 	//
@@ -23,6 +27,12 @@ type IActionClient interface {
 	//
 	// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
 	AllWithOpts(ctx context.Context, opts ActionListOpts) ([]*Action, error)
+	// Iter returns an iterator over all actions for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	//
+	// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
+	Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error]
 	// WatchOverallProgress watches several actions' progress until they complete
 	// with success or error. This watching happens in a goroutine and updates are
 	// provided through the two returned channels:
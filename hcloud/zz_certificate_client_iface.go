@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // ICertificateClient ...
@@ -24,6 +25,10 @@ type ICertificateClient interface {
 	All(ctx context.Context) ([]*Certificate, error)
 	// AllWithOpts returns all Certificates for the given options.
 	AllWithOpts(ctx context.Context, opts CertificateListOpts) ([]*Certificate, error)
+	// Iter returns an iterator over all Certificates for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts CertificateListOpts) iter.Seq2[*Certificate, error]
 	// Create creates a new uploaded certificate.
 	//
 	// Create returns an error for certificates of any other type. Use
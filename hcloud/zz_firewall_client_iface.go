@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IFirewallClient ...
@@ -24,6 +25,10 @@ type IFirewallClient interface {
 	All(ctx context.Context) ([]*Firewall, error)
 	// AllWithOpts returns all Firewalls for the given options.
 	AllWithOpts(ctx context.Context, opts FirewallListOpts) ([]*Firewall, error)
+	// Iter returns an iterator over all Firewalls for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts FirewallListOpts) iter.Seq2[*Firewall, error]
 	// Create creates a new Firewall.
 	Create(ctx context.Context, opts FirewallCreateOpts) (FirewallCreateResult, *Response, error)
 	// Update updates a Firewall.
@@ -34,4 +39,13 @@ type IFirewallClient interface {
 	SetRules(ctx context.Context, firewall *Firewall, opts FirewallSetRulesOpts) ([]*Action, *Response, error)
 	ApplyResources(ctx context.Context, firewall *Firewall, resources []FirewallResource) ([]*Action, *Response, error)
 	RemoveResources(ctx context.Context, firewall *Firewall, resources []FirewallResource) ([]*Action, *Response, error)
+	// Plan fetches the firewall and computes the changes needed to match the desired
+	// state, without applying them.
+	Plan(ctx context.Context, firewall *Firewall, opts FirewallReconcileOpts) (FirewallDiff, *Response, error)
+	// Reconcile fetches the firewall, computes the changes needed to match the desired
+	// state and applies them. The actions of each step are awaited before starting the next
+	// step, as the firewall is locked while they are running.
+	//
+	// Use [FirewallClient.Plan] to preview the changes.
+	Reconcile(ctx context.Context, firewall *Firewall, opts FirewallReconcileOpts) (FirewallReconcileResult, *Response, error)
 }
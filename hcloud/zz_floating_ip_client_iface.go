@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IFloatingIPClient ...
@@ -25,6 +26,10 @@ type IFloatingIPClient interface {
 	All(ctx context.Context) ([]*FloatingIP, error)
 	// AllWithOpts returns all Floating IPs for the given options.
 	AllWithOpts(ctx context.Context, opts FloatingIPListOpts) ([]*FloatingIP, error)
+	// Iter returns an iterator over all Floating IPs for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts FloatingIPListOpts) iter.Seq2[*FloatingIP, error]
 	// Create creates a Floating IP.
 	Create(ctx context.Context, opts FloatingIPCreateOpts) (FloatingIPCreateResult, *Response, error)
 	// Delete deletes a Floating IP.
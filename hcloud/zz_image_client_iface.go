@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IImageClient ...
@@ -39,6 +40,10 @@ type IImageClient interface {
 	All(ctx context.Context) ([]*Image, error)
 	// AllWithOpts returns all images for the given options.
 	AllWithOpts(ctx context.Context, opts ImageListOpts) ([]*Image, error)
+	// Iter returns an iterator over all images for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts ImageListOpts) iter.Seq2[*Image, error]
 	// Delete deletes an image.
 	Delete(ctx context.Context, image *Image) (*Response, error)
 	// Update updates an image.
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 	"net"
 )
 
@@ -25,6 +26,10 @@ type ILoadBalancerClient interface {
 	All(ctx context.Context) ([]*LoadBalancer, error)
 	// AllWithOpts returns all Load Balancers for the given options.
 	AllWithOpts(ctx context.Context, opts LoadBalancerListOpts) ([]*LoadBalancer, error)
+	// Iter returns an iterator over all Load Balancers for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts LoadBalancerListOpts) iter.Seq2[*LoadBalancer, error]
 	// Update updates a Load Balancer.
 	Update(ctx context.Context, loadBalancer *LoadBalancer, opts LoadBalancerUpdateOpts) (*LoadBalancer, *Response, error)
 	// Create creates a new Load Balancer.
@@ -68,4 +73,13 @@ type ILoadBalancerClient interface {
 	// ChangeDNSPtr changes or resets the reverse DNS pointer for a Load Balancer.
 	// Pass a nil ptr to reset the reverse DNS pointer to its default value.
 	ChangeDNSPtr(ctx context.Context, lb *LoadBalancer, ip string, ptr *string) (*Action, *Response, error)
+	// Plan fetches the Load Balancer and computes the changes needed to match the desired
+	// state, without applying them.
+	Plan(ctx context.Context, loadBalancer *LoadBalancer, opts LoadBalancerReconcileOpts) (LoadBalancerDiff, *Response, error)
+	// Reconcile fetches the Load Balancer, computes the changes needed to match the desired
+	// state, and applies them one after the other, waiting for each resulting action to
+	// complete before applying the next change.
+	//
+	// Use [LoadBalancerClient.Plan] to preview the changes.
+	Reconcile(ctx context.Context, loadBalancer *LoadBalancer, opts LoadBalancerReconcileOpts) (LoadBalancerReconcileResult, *Response, error)
 }
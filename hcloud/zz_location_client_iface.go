@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // ILocationClient ...
@@ -24,4 +25,8 @@ type ILocationClient interface {
 	All(ctx context.Context) ([]*Location, error)
 	// AllWithOpts returns all locations for the given options.
 	AllWithOpts(ctx context.Context, opts LocationListOpts) ([]*Location, error)
+	// Iter returns an iterator over all locations for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts LocationListOpts) iter.Seq2[*Location, error]
 }
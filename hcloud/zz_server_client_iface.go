@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IServerClient ...
@@ -24,6 +25,10 @@ type IServerClient interface {
 	All(ctx context.Context) ([]*Server, error)
 	// AllWithOpts returns all servers for the given options.
 	AllWithOpts(ctx context.Context, opts ServerListOpts) ([]*Server, error)
+	// Iter returns an iterator over all servers for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	Iter(ctx context.Context, opts ServerListOpts) iter.Seq2[*Server, error]
 	// Create creates a new server.
 	Create(ctx context.Context, opts ServerCreateOpts) (ServerCreateResult, *Response, error)
 	// Delete deletes a server.
@@ -86,4 +91,16 @@ type IServerClient interface {
 	GetMetrics(ctx context.Context, server *Server, opts ServerGetMetricsOpts) (*ServerMetrics, *Response, error)
 	AddToPlacementGroup(ctx context.Context, server *Server, placementGroup *PlacementGroup) (*Action, *Response, error)
 	RemoveFromPlacementGroup(ctx context.Context, server *Server) (*Action, *Response, error)
+	// CreateAndWait creates a server, waits for the actions triggered by its creation,
+	// and waits until the server is running (or off, when [ServerCreateOpts.StartAfterCreate]
+	// is false).
+	//
+	// The returned result holds the reloaded server. If a step fails after the server was
+	// created, the result holds the last known state of the server and, when
+	// [ServerCreateAndWaitOpts.Rollback] is set, the server and the primary IPs allocated
+	// for it are deleted. The rollback is not canceled with the context.
+	//
+	// CreateAndWait uses the [WithPollOpts] of the [Client] to wait until sending the next
+	// request.
+	CreateAndWait(ctx context.Context, opts ServerCreateOpts, waitOpts ServerCreateAndWaitOpts) (ServerCreateResult, *Response, error)
 }
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IStorageBoxClient ...
@@ -37,6 +38,12 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
 	AllWithOpts(ctx context.Context, opts StorageBoxListOpts) ([]*StorageBox, error)
+	// Iter returns an iterator over all [StorageBox] with the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
+	Iter(ctx context.Context, opts StorageBoxListOpts) iter.Seq2[*StorageBox, error]
 	// Create creates a new [StorageBox] with the given options.
 	//
 	// To provide SSH keys, populate the PublicKey field for each [SSHKey]
@@ -109,6 +116,12 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
 	AllSnapshotsWithOpts(ctx context.Context, storageBox *StorageBox, opts StorageBoxSnapshotListOpts) ([]*StorageBoxSnapshot, error)
+	// IterSnapshots returns an iterator over all [StorageBoxSnapshot] of a [StorageBox] with the given options.
+	//
+	// Pagination is not supported, so all [StorageBoxSnapshot] are fetched at once when iterating.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
+	IterSnapshots(ctx context.Context, storageBox *StorageBox, opts StorageBoxSnapshotListOpts) iter.Seq2[*StorageBoxSnapshot, error]
 	// AllSnapshots lists all [StorageBoxSnapshot] of a [StorageBox] without any options.
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
@@ -151,6 +164,12 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
 	AllSubaccountsWithOpts(ctx context.Context, storageBox *StorageBox, opts StorageBoxSubaccountListOpts) ([]*StorageBoxSubaccount, error)
+	// IterSubaccounts returns an iterator over all [StorageBoxSubaccount] of a [StorageBox] with the given options.
+	//
+	// Pagination is not supported, so all [StorageBoxSubaccount] are fetched at once when iterating.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
+	IterSubaccounts(ctx context.Context, storageBox *StorageBox, opts StorageBoxSubaccountListOpts) iter.Seq2[*StorageBoxSubaccount, error]
 	// AllSubaccounts retrieves all [StorageBoxSubaccount] of a [StorageBox].
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IStorageBoxTypeClient ...
@@ -20,6 +21,12 @@ type IStorageBoxTypeClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-list-storage-box-types
 	AllWithOpts(ctx context.Context, opts StorageBoxTypeListOpts) ([]*StorageBoxType, error)
+	// Iter returns an iterator over all storage box types for the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-list-storage-box-types
+	Iter(ctx context.Context, opts StorageBoxTypeListOpts) iter.Seq2[*StorageBoxType, error]
 	// GetByID returns a specific Storage Box Type by ID.
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-get-a-storage-box-type
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IZoneClient ...
@@ -32,6 +33,12 @@ type IZoneClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
 	AllWithOpts(ctx context.Context, opts ZoneListOpts) ([]*Zone, error)
+	// Iter returns an iterator over all [Zone] with the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	//
+	// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
+	Iter(ctx context.Context, opts ZoneListOpts) iter.Seq2[*Zone, error]
 	// Create creates a new [Zone] from the given options.
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zones-create-a-zone
@@ -80,6 +87,12 @@ type IZoneClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
 	AllRRSetsWithOpts(ctx context.Context, zone *Zone, opts ZoneRRSetListOpts) ([]*ZoneRRSet, error)
+	// IterRRSets returns an iterator over all [ZoneRRSet] with the given options.
+	//
+	// Pages are fetched lazily while iterating, and iterating stops on the first error.
+	//
+	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
+	IterRRSets(ctx context.Context, zone *Zone, opts ZoneRRSetListOpts) iter.Seq2[*ZoneRRSet, error]
 	// AllRRSets returns a list of all [ZoneRRSet].
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets